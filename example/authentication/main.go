@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/sns-sdks/go-pinterest/pinterest"
 	"os"
+	"time"
 )
 
 var (
@@ -15,21 +17,17 @@ func main() {
 	app := pinterest.NewAuthorizationAPP(pinterest.AuthorizationAPP{
 		ClientID:     AppID,
 		ClientSecret: AppSecret,
-		RedirectURI:  "https://localhost/",
-		Scope:        "pins:read,user_accounts:read",
+		// The redirect uri must be registered in the app settings.
+		RedirectURI: "http://localhost:8085/",
+		Scopes:      []pinterest.Scope{pinterest.ScopePinsRead, pinterest.ScopeUserAccountsRead},
 	})
 	fmt.Println(app.String())
 
-	authUrl := app.GetAuthorizationURL()
-	fmt.Println(authUrl)
-
-	fmt.Println("Enter Code: ")
-	var code string
-	//"https://localhost/?code=b26f3de00c9d923a5b3a116716628b557e1c9854&state=go-pinterest"
-	fmt.Scanln(&code)
-	token, err := app.GenerateAccessToken(code)
+	// Open the printed url in the browser, the token is returned after you authorized the app.
+	token, err := app.LoginWithLoopback(context.Background(), pinterest.LoopbackLoginOpts{Timeout: 3 * time.Minute})
 	if err != nil {
 		fmt.Println(err)
+		return
 	}
 	fmt.Println(token)
 
@@ -1,40 +1,47 @@
 package pinterest
 
+import "context"
+
 // Ad represents the ad info.
 type Ad struct {
-	ID                                    *string       `json:"id"`
-	Type                                  *string       `json:"type"`
-	AdAccountID                           *string       `json:"ad_account_id"`
-	AdGroupID                             *string       `json:"ad_group_id"`
-	CampaignID                            *string       `json:"campaign_id"`
-	PinID                                 *string       `json:"pin_id"`
-	Name                                  *string       `json:"name"`
-	Status                                *string       `json:"status"`
-	AndroidDeepLink                       *string       `json:"android_deep_link"`
-	IOSDeepLink                           *string       `json:"ios_deep_link"`
-	CarouselAndroidDeepLinks              []*string     `json:"carousel_android_deep_links"`
-	CarouselDestinationURLs               []*string     `json:"carousel_destination_urls"`
-	CarouselIOSDeepLinks                  []*string     `json:"carousel_ios_deep_links"`
-	ClickTrackingURL                      *string       `json:"click_tracking_url"`
-	CreativeType                          *string       `json:"creative_type"`
-	DestinationURL                        *string       `json:"destination_url"`
-	IsPinDeleted                          *bool         `json:"is_pin_deleted"`
-	IsRemovable                           *bool         `json:"is_removable"`
-	TrackingURLs                          *TrackingURLs `json:"tracking_urls"`
-	ViewTrackingURL                       *string       `json:"view_tracking_url"`
-	CollectionItemsDestinationURLTemplate *string       `json:"collection_items_destination_url_template"`
-	CreatedTime                           *int          `json:"created_time"`
-	UpdatedTime                           *int          `json:"updated_time"`
-	RejectedReasons                       []*string     `json:"rejected_reasons"`
-	RejectionLabels                       []*string     `json:"rejection_labels"`
-	ReviewStatus                          *string       `json:"review_status"`
-	SummaryStatus                         *string       `json:"summary_status"`
+	ID                                    *string         `json:"id"`
+	Type                                  *string         `json:"type"`
+	AdAccountID                           *string         `json:"ad_account_id"`
+	AdGroupID                             *string         `json:"ad_group_id"`
+	CampaignID                            *string         `json:"campaign_id"`
+	PinID                                 *string         `json:"pin_id"`
+	Name                                  *string         `json:"name"`
+	Status                                *EntityStatus   `json:"status"`
+	AndroidDeepLink                       *string         `json:"android_deep_link"`
+	IOSDeepLink                           *string         `json:"ios_deep_link"`
+	CarouselAndroidDeepLinks              []*string       `json:"carousel_android_deep_links"`
+	CarouselDestinationURLs               []*string       `json:"carousel_destination_urls"`
+	CarouselIOSDeepLinks                  []*string       `json:"carousel_ios_deep_links"`
+	ClickTrackingURL                      *string         `json:"click_tracking_url"`
+	CreativeType                          *CreativeType   `json:"creative_type"`
+	DestinationURL                        *string         `json:"destination_url"`
+	IsPinDeleted                          *bool           `json:"is_pin_deleted"`
+	IsRemovable                           *bool           `json:"is_removable"`
+	TrackingURLs                          *TrackingURLs   `json:"tracking_urls"`
+	ViewTrackingURL                       *string         `json:"view_tracking_url"`
+	CollectionItemsDestinationURLTemplate *string         `json:"collection_items_destination_url_template"`
+	CreatedTime                           *UnixTime       `json:"created_time"`
+	UpdatedTime                           *UnixTime       `json:"updated_time"`
+	RejectedReasons                       []*string       `json:"rejected_reasons"`
+	RejectionLabels                       []*string       `json:"rejection_labels"`
+	ReviewStatus                          *AdReviewStatus `json:"review_status"`
+	SummaryStatus                         *string         `json:"summary_status"`
 }
 
 func (a Ad) String() string {
 	return Stringify(a)
 }
 
+// IsRejected Check if the ad is rejected by the review.
+func (a *Ad) IsRejected() bool {
+	return a.ReviewStatus != nil && *a.ReviewStatus == AdReviewStatusRejected
+}
+
 // AdsResponse represents the response for list ads.
 type AdsResponse struct {
 	Items    []*Ad   `json:"items"`
@@ -47,51 +54,86 @@ func (a AdsResponse) String() string {
 
 // ListAdsOpts represents the parameters for list ads.
 type ListAdsOpts struct {
-	CampaignIDs               []string `url:"campaign_ids"`
-	AdGroupIDs                []string `url:"ad_group_ids"`
-	AdIDs                     []string `url:"ad_ids"`
-	EntityStatuses            []string `url:"entity_statuses"`
-	Order                     string   `url:"order"`
-	TranslateInterestsToNames bool     `url:"translate_interests_to_names"`
+	CampaignIDs               []string       `url:"campaign_ids"`
+	AdGroupIDs                []string       `url:"ad_group_ids"`
+	AdIDs                     []string       `url:"ad_ids"`
+	EntityStatuses            []EntityStatus `url:"entity_statuses"`
+	Order                     string         `url:"order"`
+	TranslateInterestsToNames bool           `url:"translate_interests_to_names"`
 	ListOptions
 }
 
+// Validate Check the parameters before sending the request.
+func (l ListAdsOpts) Validate() error {
+	return validateEnums("entity_statuses", l.EntityStatuses)
+}
+
 // ListAds Get a list of the ads in the specified ad_account_id, filtered by the specified options.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/ads/list
-func (r *AdAccountResource) ListAds(adAccountID string, args ListAdsOpts) (*AdsResponse, *APIError) {
+func (r *AdAccountResource) ListAds(adAccountID string, args ListAdsOpts) (*AdsResponse, error) {
+	return r.ListAdsWithContext(context.Background(), adAccountID, args)
+}
+
+// ListAdsWithContext is the same as ListAds, but with a context for the request.
+func (r *AdAccountResource) ListAdsWithContext(ctx context.Context, adAccountID string, args ListAdsOpts) (*AdsResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/ad_accounts/" + adAccountID + "/ads"
 
 	resp := new(AdsResponse)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// ListAdsIter Return an iterator walking through all the ads, the pages are fetched by the bookmark.
+func (r *AdAccountResource) ListAdsIter(adAccountID string, args ListAdsOpts) *Iterator[*Ad] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*Ad, *string, error) {
+		args.ListOptions = opts
+		resp, err := r.ListAdsWithContext(ctx, adAccountID, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args.ListOptions)
+}
+
 // GetAdAnalyticsOpts represents the parameters for Get ad analytics.
 type GetAdAnalyticsOpts struct {
-	StartDate            string   `url:"start_date"`
-	EndDate              string   `url:"end_date"`
-	AdIDs                []string `url:"ad_ids"`
-	Columns              []string `url:"columns"`
-	Granularity          string   `url:"granularity"`
-	ClickWindowDays      int      `url:"click_window_days,omitempty"`
-	EngagementWindowDays int      `url:"engagement_window_days,omitempty"`
-	ViewWindowDays       int      `url:"view_window_days,omitempty"`
-	ConversionReportTime string   `url:"conversion_report_time,omitempty"`
+	StartDate            string            `url:"start_date"`
+	EndDate              string            `url:"end_date"`
+	AdIDs                []string          `url:"ad_ids"`
+	Columns              []AnalyticsColumn `url:"columns"`
+	Granularity          Granularity       `url:"granularity"`
+	ClickWindowDays      int               `url:"click_window_days,omitempty"`
+	EngagementWindowDays int               `url:"engagement_window_days,omitempty"`
+	ViewWindowDays       int               `url:"view_window_days,omitempty"`
+	ConversionReportTime string            `url:"conversion_report_time,omitempty"`
 }
 
-// AnalyticsResponse represents the analytics response.
-type AnalyticsResponse []map[string]interface{}
+// Validate Check the parameters before sending the request.
+func (g GetAdAnalyticsOpts) Validate() error {
+	return validateAnalytics(g.Columns, g.Granularity)
+}
 
 // GetAdAnalytics Get analytics for the specified ads in the specified ad_account_id, filtered by the specified options.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/ads/analytics
-func (r *AdAccountResource) GetAdAnalytics(adAccountID string, args GetAdAnalyticsOpts) (AnalyticsResponse, *APIError) {
+func (r *AdAccountResource) GetAdAnalytics(adAccountID string, args GetAdAnalyticsOpts) (AnalyticsResponse, error) {
+	return r.GetAdAnalyticsWithContext(context.Background(), adAccountID, args)
+}
+
+// GetAdAnalyticsWithContext is the same as GetAdAnalytics, but with a context for the request.
+func (r *AdAccountResource) GetAdAnalyticsWithContext(ctx context.Context, adAccountID string, args GetAdAnalyticsOpts) (AnalyticsResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/ad_accounts/" + adAccountID + "/ads/analytics"
 
 	var resp AnalyticsResponse
-	err := r.Cli.DoGet(path, args, &resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -100,24 +142,268 @@ func (r *AdAccountResource) GetAdAnalytics(adAccountID string, args GetAdAnalyti
 
 // GetProductGroupAnalyticsOpts represents the parameters for Get product group analytics.
 type GetProductGroupAnalyticsOpts struct {
-	StartDate            string   `url:"start_date"`
-	EndDate              string   `url:"end_date"`
-	ProductGroupIDs      []string `url:"product_group_ids"`
-	Columns              []string `url:"columns"`
-	Granularity          string   `url:"granularity"`
-	ClickWindowDays      int      `url:"click_window_days,omitempty"`
-	EngagementWindowDays int      `url:"engagement_window_days,omitempty"`
-	ViewWindowDays       int      `url:"view_window_days,omitempty"`
-	ConversionReportTime string   `url:"conversion_report_time,omitempty"`
+	StartDate            string            `url:"start_date"`
+	EndDate              string            `url:"end_date"`
+	ProductGroupIDs      []string          `url:"product_group_ids"`
+	Columns              []AnalyticsColumn `url:"columns"`
+	Granularity          Granularity       `url:"granularity"`
+	ClickWindowDays      int               `url:"click_window_days,omitempty"`
+	EngagementWindowDays int               `url:"engagement_window_days,omitempty"`
+	ViewWindowDays       int               `url:"view_window_days,omitempty"`
+	ConversionReportTime string            `url:"conversion_report_time,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (g GetProductGroupAnalyticsOpts) Validate() error {
+	return validateAnalytics(g.Columns, g.Granularity)
 }
 
 // GetProductGroupAnalytics Get analytics for the specified product groups in the specified ad_account_id, filtered by the specified options.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/product_groups/analytics
-func (r *AdAccountResource) GetProductGroupAnalytics(adAccountID string, args GetProductGroupAnalyticsOpts) (AnalyticsResponse, *APIError) {
+func (r *AdAccountResource) GetProductGroupAnalytics(adAccountID string, args GetProductGroupAnalyticsOpts) (AnalyticsResponse, error) {
+	return r.GetProductGroupAnalyticsWithContext(context.Background(), adAccountID, args)
+}
+
+// GetProductGroupAnalyticsWithContext is the same as GetProductGroupAnalytics, but with a context for the request.
+func (r *AdAccountResource) GetProductGroupAnalyticsWithContext(ctx context.Context, adAccountID string, args GetProductGroupAnalyticsOpts) (AnalyticsResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/ad_accounts/" + adAccountID + "/product_groups/analytics"
 
 	var resp AnalyticsResponse
-	err := r.Cli.DoGet(path, args, &resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AdRejection represents the rejected ad in the batch response, with the reasons from the review.
+type AdRejection struct {
+	Index   int
+	AdID    string
+	Reasons []string
+	Labels  []string
+}
+
+func (a AdRejection) String() string {
+	return Stringify(a)
+}
+
+// AdsBatchResponse represents the response for create or update ads.
+type AdsBatchResponse struct {
+	BatchResponse[Ad]
+}
+
+// Rejections Return the ads rejected by the review, the failed items are not included, check them by Errors.
+func (r *AdsBatchResponse) Rejections() []*AdRejection {
+	var rejections []*AdRejection
+	for i, item := range r.Items {
+		if item.Data == nil || !item.Data.IsRejected() {
+			continue
+		}
+		rejection := &AdRejection{Index: i}
+		if item.Data.ID != nil {
+			rejection.AdID = *item.Data.ID
+		}
+		for _, reason := range item.Data.RejectedReasons {
+			if reason != nil {
+				rejection.Reasons = append(rejection.Reasons, *reason)
+			}
+		}
+		for _, label := range item.Data.RejectionLabels {
+			if label != nil {
+				rejection.Labels = append(rejection.Labels, *label)
+			}
+		}
+		rejections = append(rejections, rejection)
+	}
+	return rejections
+}
+
+// CreateAdOpts represents the parameters for create an ad.
+type CreateAdOpts struct {
+	// AdAccountID is set by CreateAds from the ad account id argument.
+	AdAccountID              string        `json:"ad_account_id"`
+	AdGroupID                string        `json:"ad_group_id"`
+	CreativeType             CreativeType  `json:"creative_type"`
+	PinID                    string        `json:"pin_id"`
+	Name                     string        `json:"name,omitempty"`
+	Status                   EntityStatus  `json:"status,omitempty"`
+	DestinationURL           string        `json:"destination_url,omitempty"`
+	AndroidDeepLink          string        `json:"android_deep_link,omitempty"`
+	IOSDeepLink              string        `json:"ios_deep_link,omitempty"`
+	CarouselAndroidDeepLinks []string      `json:"carousel_android_deep_links,omitempty"`
+	CarouselDestinationURLs  []string      `json:"carousel_destination_urls,omitempty"`
+	CarouselIOSDeepLinks     []string      `json:"carousel_ios_deep_links,omitempty"`
+	ClickTrackingURL         string        `json:"click_tracking_url,omitempty"`
+	ViewTrackingURL          string        `json:"view_tracking_url,omitempty"`
+	TrackingURLs             *TrackingURLs `json:"tracking_urls,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (c CreateAdOpts) Validate() error {
+	if c.AdGroupID == "" {
+		return &ValidationError{Field: "ad_group_id", Message: "required"}
+	}
+	if c.PinID == "" {
+		return &ValidationError{Field: "pin_id", Message: "required"}
+	}
+	if c.CreativeType == "" {
+		return &ValidationError{Field: "creative_type", Message: "required"}
+	}
+	if err := validateEnum("creative_type", c.CreativeType); err != nil {
+		return err
+	}
+	return validateEnum("status", c.Status)
+}
+
+// UpdateAdOpts represents the parameters for update an ad, only the set fields are changed.
+type UpdateAdOpts struct {
+	// AdAccountID is set by UpdateAds from the ad account id argument.
+	AdAccountID              string        `json:"ad_account_id"`
+	ID                       string        `json:"id"`
+	Name                     *string       `json:"name,omitempty"`
+	Status                   EntityStatus  `json:"status,omitempty"`
+	DestinationURL           *string       `json:"destination_url,omitempty"`
+	AndroidDeepLink          *string       `json:"android_deep_link,omitempty"`
+	IOSDeepLink              *string       `json:"ios_deep_link,omitempty"`
+	CarouselAndroidDeepLinks []string      `json:"carousel_android_deep_links,omitempty"`
+	CarouselDestinationURLs  []string      `json:"carousel_destination_urls,omitempty"`
+	CarouselIOSDeepLinks     []string      `json:"carousel_ios_deep_links,omitempty"`
+	ClickTrackingURL         *string       `json:"click_tracking_url,omitempty"`
+	ViewTrackingURL          *string       `json:"view_tracking_url,omitempty"`
+	TrackingURLs             *TrackingURLs `json:"tracking_urls,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (u UpdateAdOpts) Validate() error {
+	if u.ID == "" {
+		return &ValidationError{Field: "id", Message: "required"}
+	}
+	return validateEnum("status", u.Status)
+}
+
+// CreateAds Create multiple new ads. Request must contain ad_group_id, creative_type, and the source Pin pin_id.
+// The items may fail on their own, check the Errors of the response, and the Rejections for the review results.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/ads/create
+func (r *AdAccountResource) CreateAds(adAccountID string, args []CreateAdOpts) (*AdsBatchResponse, error) {
+	return r.CreateAdsWithContext(context.Background(), adAccountID, args)
+}
+
+// CreateAdsWithContext is the same as CreateAds, but with a context for the request.
+func (r *AdAccountResource) CreateAdsWithContext(ctx context.Context, adAccountID string, args []CreateAdOpts) (*AdsBatchResponse, error) {
+	items := make([]CreateAdOpts, 0, len(args))
+	for i, opts := range args {
+		if err := opts.Validate(); err != nil {
+			return nil, batchValidationError(i, err)
+		}
+		opts.AdAccountID = adAccountID
+		items = append(items, opts)
+	}
+	path := "/ad_accounts/" + adAccountID + "/ads"
+
+	resp := new(AdsBatchResponse)
+	err := r.Cli.DoPostWithContext(ctx, path, items, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateAds Update multiple existing ads.
+// The items may fail on their own, check the Errors of the response, and the Rejections for the review results.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/ads/update
+func (r *AdAccountResource) UpdateAds(adAccountID string, args []UpdateAdOpts) (*AdsBatchResponse, error) {
+	return r.UpdateAdsWithContext(context.Background(), adAccountID, args)
+}
+
+// UpdateAdsWithContext is the same as UpdateAds, but with a context for the request.
+func (r *AdAccountResource) UpdateAdsWithContext(ctx context.Context, adAccountID string, args []UpdateAdOpts) (*AdsBatchResponse, error) {
+	items := make([]UpdateAdOpts, 0, len(args))
+	for i, opts := range args {
+		if err := opts.Validate(); err != nil {
+			return nil, batchValidationError(i, err)
+		}
+		opts.AdAccountID = adAccountID
+		items = append(items, opts)
+	}
+	path := "/ad_accounts/" + adAccountID + "/ads"
+
+	resp := new(AdsBatchResponse)
+	err := r.Cli.DoPatchWithContext(ctx, path, items, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetAd Get a specific ad given the ad ID.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/ads/get
+func (r *AdAccountResource) GetAd(adAccountID, adID string) (*Ad, error) {
+	return r.GetAdWithContext(context.Background(), adAccountID, adID)
+}
+
+// GetAdWithContext is the same as GetAd, but with a context for the request.
+func (r *AdAccountResource) GetAdWithContext(ctx context.Context, adAccountID, adID string) (*Ad, error) {
+	path := "/ad_accounts/" + adAccountID + "/ads/" + adID
+
+	resp := new(Ad)
+	err := r.Cli.DoGetWithContext(ctx, path, nil, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateAdPreviewOpts represents the parameters for create an ad preview.
+// Either PinID, or ImageURL and Title should be given.
+type CreateAdPreviewOpts struct {
+	PinID    string `json:"pin_id,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	Title    string `json:"title,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (c CreateAdPreviewOpts) Validate() error {
+	switch {
+	case c.PinID != "" && c.ImageURL != "":
+		return &ValidationError{Field: "pin_id", Message: "only one of pin_id and image_url is allowed"}
+	case c.PinID != "":
+		return nil
+	case c.ImageURL == "":
+		return &ValidationError{Field: "pin_id", Message: "one of pin_id and image_url is required"}
+	case c.Title == "":
+		return &ValidationError{Field: "title", Message: "required for image_url"}
+	}
+	return nil
+}
+
+// AdPreview represents the preview of an ad.
+type AdPreview struct {
+	URL *string `json:"url"`
+}
+
+func (a AdPreview) String() string {
+	return Stringify(a)
+}
+
+// CreateAdPreview Create an ad preview given an ad account ID and either an existing organic pin ID or the URL for an image to be used to create the pin and the ad.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/ad_previews/create
+func (r *AdAccountResource) CreateAdPreview(adAccountID string, args CreateAdPreviewOpts) (*AdPreview, error) {
+	return r.CreateAdPreviewWithContext(context.Background(), adAccountID, args)
+}
+
+// CreateAdPreviewWithContext is the same as CreateAdPreview, but with a context for the request.
+func (r *AdAccountResource) CreateAdPreviewWithContext(ctx context.Context, adAccountID string, args CreateAdPreviewOpts) (*AdPreview, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/ad_accounts/" + adAccountID + "/ad_previews"
+
+	resp := new(AdPreview)
+	err := r.Cli.DoPostWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1,5 +1,7 @@
 package pinterest
 
+import "context"
+
 /*
 	Ad Accounts API
 */
@@ -45,36 +47,66 @@ type ListAdAccountsOpts struct {
 
 // ListAdAccounts Get a list of the ad_accounts that the "operation user_account" has access to.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/ad_accounts/list
-func (r *AdAccountResource) ListAdAccounts(args ListAdAccountsOpts) (*AdAccountsResponse, *APIError) {
+func (r *AdAccountResource) ListAdAccounts(args ListAdAccountsOpts) (*AdAccountsResponse, error) {
+	return r.ListAdAccountsWithContext(context.Background(), args)
+}
+
+// ListAdAccountsWithContext is the same as ListAdAccounts, but with a context for the request.
+func (r *AdAccountResource) ListAdAccountsWithContext(ctx context.Context, args ListAdAccountsOpts) (*AdAccountsResponse, error) {
 	path := "/ad_accounts"
 
 	resp := new(AdAccountsResponse)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// ListAdAccountsIter Return an iterator walking through all the ad accounts, the pages are fetched by the bookmark.
+func (r *AdAccountResource) ListAdAccountsIter(args ListAdAccountsOpts) *Iterator[*AdAccount] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*AdAccount, *string, error) {
+		args.ListOptions = opts
+		resp, err := r.ListAdAccountsWithContext(ctx, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args.ListOptions)
+}
+
 // GetAdAccountAnalyticsOpts represents the parameters for Get ad account analytics.
 type GetAdAccountAnalyticsOpts struct {
-	StartDate            string   `url:"start_date"`
-	EndDate              string   `url:"end_date"`
-	Columns              []string `url:"columns"`
-	Granularity          string   `url:"granularity"`
-	ClickWindowDays      int      `url:"click_window_days,omitempty"`
-	EngagementWindowDays int      `url:"engagement_window_days,omitempty"`
-	ViewWindowDays       int      `url:"view_window_days,omitempty"`
-	ConversionReportTime string   `url:"conversion_report_time,omitempty"`
+	StartDate            string            `url:"start_date"`
+	EndDate              string            `url:"end_date"`
+	Columns              []AnalyticsColumn `url:"columns"`
+	Granularity          Granularity       `url:"granularity"`
+	ClickWindowDays      int               `url:"click_window_days,omitempty"`
+	EngagementWindowDays int               `url:"engagement_window_days,omitempty"`
+	ViewWindowDays       int               `url:"view_window_days,omitempty"`
+	ConversionReportTime string            `url:"conversion_report_time,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (g GetAdAccountAnalyticsOpts) Validate() error {
+	return validateAnalytics(g.Columns, g.Granularity)
 }
 
 // GetAdAccountAnalytics Get analytics for the specified ad_account_id, filtered by the specified options.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/ad_account/analytics
-func (r *AdAccountResource) GetAdAccountAnalytics(adAccountID string, args GetAdAccountAnalyticsOpts) (AnalyticsResponse, *APIError) {
+func (r *AdAccountResource) GetAdAccountAnalytics(adAccountID string, args GetAdAccountAnalyticsOpts) (AnalyticsResponse, error) {
+	return r.GetAdAccountAnalyticsWithContext(context.Background(), adAccountID, args)
+}
+
+// GetAdAccountAnalyticsWithContext is the same as GetAdAccountAnalytics, but with a context for the request.
+func (r *AdAccountResource) GetAdAccountAnalyticsWithContext(ctx context.Context, adAccountID string, args GetAdAccountAnalyticsOpts) (AnalyticsResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/ad_accounts/" + adAccountID + "/analytics"
 
 	var resp AnalyticsResponse
-	err := r.Cli.DoGet(path, args, &resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, &resp)
 	if err != nil {
 		return nil, err
 	}
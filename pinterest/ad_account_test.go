@@ -49,5 +49,5 @@ func (bc *BCSuite) TestGetAdAccountAnalytics() {
 	)
 
 	analytics, _ := bc.Pin.AdAccount.GetAdAccountAnalytics(adAccountID, GetAdAccountAnalyticsOpts{})
-	bc.Equal(analytics[0]["DATE"], "2021-04-01")
+	bc.Equal(analytics[0].Date.Format(DateLayout), "2021-04-01")
 }
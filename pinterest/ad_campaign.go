@@ -1,5 +1,7 @@
 package pinterest
 
+import "context"
+
 // TrackingURLs represents the Third-party tracking URLs.
 type TrackingURLs struct {
 	Impression           []*string `json:"impression"`
@@ -15,20 +17,20 @@ func (t TrackingURLs) String() string {
 
 // Campaign represents the campaign info.
 type Campaign struct {
-	ID               *string       `json:"id"`
-	Type             *string       `json:"type"`
-	AdAccountID      *string       `json:"ad_account_id"`
-	Name             *string       `json:"name"`
-	Status           *string       `json:"status"`
-	LifetimeSpendCap *int          `json:"lifetime_spend_cap"`
-	DailySpendCap    *int          `json:"daily_spend_cap"`
-	OrderLineID      *string       `json:"order_line_id"`
-	TrackingURLs     *TrackingURLs `json:"tracking_urls"`
-	StartTime        *int          `json:"start_time"`
-	EndTime          *int          `json:"end_time"`
-	ObjectiveType    *string       `json:"objective_type"`
-	CreatedTime      *int          `json:"created_time"`
-	UpdatedTime      *int          `json:"updated_time"`
+	ID               *string        `json:"id"`
+	Type             *string        `json:"type"`
+	AdAccountID      *string        `json:"ad_account_id"`
+	Name             *string        `json:"name"`
+	Status           *EntityStatus  `json:"status"`
+	LifetimeSpendCap *MicroCurrency `json:"lifetime_spend_cap"`
+	DailySpendCap    *MicroCurrency `json:"daily_spend_cap"`
+	OrderLineID      *string        `json:"order_line_id"`
+	TrackingURLs     *TrackingURLs  `json:"tracking_urls"`
+	StartTime        *UnixTime      `json:"start_time"`
+	EndTime          *UnixTime      `json:"end_time"`
+	ObjectiveType    *ObjectiveType `json:"objective_type"`
+	CreatedTime      *UnixTime      `json:"created_time"`
+	UpdatedTime      *UnixTime      `json:"updated_time"`
 }
 
 func (c Campaign) String() string {
@@ -47,47 +49,267 @@ func (c CampaignsResponse) String() string {
 
 // ListCampaignsOpts represents the parameters for list campaigns.
 type ListCampaignsOpts struct {
-	CampaignIDs    []string `url:"campaign_ids"`
-	EntityStatuses []string `url:"entity_statuses"`
-	Order          string   `url:"order"`
+	CampaignIDs    []string       `url:"campaign_ids"`
+	EntityStatuses []EntityStatus `url:"entity_statuses"`
+	Order          string         `url:"order"`
 	ListOptions
 }
 
+// Validate Check the parameters before sending the request.
+func (l ListCampaignsOpts) Validate() error {
+	return validateEnums("entity_statuses", l.EntityStatuses)
+}
+
 // ListCampaigns Get a list of the campaigns in the specified ad_account_id, filtered by the specified options.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/campaigns/list
-func (r *AdAccountResource) ListCampaigns(adAccountID string, args ListCampaignsOpts) (*CampaignsResponse, *APIError) {
+func (r *AdAccountResource) ListCampaigns(adAccountID string, args ListCampaignsOpts) (*CampaignsResponse, error) {
+	return r.ListCampaignsWithContext(context.Background(), adAccountID, args)
+}
+
+// ListCampaignsWithContext is the same as ListCampaigns, but with a context for the request.
+func (r *AdAccountResource) ListCampaignsWithContext(ctx context.Context, adAccountID string, args ListCampaignsOpts) (*CampaignsResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/ad_accounts/" + adAccountID + "/campaigns"
 
 	resp := new(CampaignsResponse)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// ListCampaignsIter Return an iterator walking through all the campaigns, the pages are fetched by the bookmark.
+func (r *AdAccountResource) ListCampaignsIter(adAccountID string, args ListCampaignsOpts) *Iterator[*Campaign] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*Campaign, *string, error) {
+		args.ListOptions = opts
+		resp, err := r.ListCampaignsWithContext(ctx, adAccountID, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args.ListOptions)
+}
+
 // GetCampaignAnalyticsOpts represents the parameters for Get campaign analytics.
 type GetCampaignAnalyticsOpts struct {
-	StartDate            string   `url:"start_date"`
-	EndDate              string   `url:"end_date"`
-	CampaignIDs          []string `url:"campaign_ids"`
-	Columns              []string `url:"columns"`
-	Granularity          string   `url:"granularity"`
-	ClickWindowDays      int      `url:"click_window_days,omitempty"`
-	EngagementWindowDays int      `url:"engagement_window_days,omitempty"`
-	ViewWindowDays       int      `url:"view_window_days,omitempty"`
-	ConversionReportTime string   `url:"conversion_report_time,omitempty"`
+	StartDate            string            `url:"start_date"`
+	EndDate              string            `url:"end_date"`
+	CampaignIDs          []string          `url:"campaign_ids"`
+	Columns              []AnalyticsColumn `url:"columns"`
+	Granularity          Granularity       `url:"granularity"`
+	ClickWindowDays      int               `url:"click_window_days,omitempty"`
+	EngagementWindowDays int               `url:"engagement_window_days,omitempty"`
+	ViewWindowDays       int               `url:"view_window_days,omitempty"`
+	ConversionReportTime string            `url:"conversion_report_time,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (g GetCampaignAnalyticsOpts) Validate() error {
+	return validateAnalytics(g.Columns, g.Granularity)
 }
 
 // GetCampaignAnalytics Get analytics for the specified campaigns in the specified ad_account_id, filtered by the specified options.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/campaigns/analytics
-func (r *AdAccountResource) GetCampaignAnalytics(adAccountID string, args GetCampaignAnalyticsOpts) (AnalyticsResponse, *APIError) {
+func (r *AdAccountResource) GetCampaignAnalytics(adAccountID string, args GetCampaignAnalyticsOpts) (AnalyticsResponse, error) {
+	return r.GetCampaignAnalyticsWithContext(context.Background(), adAccountID, args)
+}
+
+// GetCampaignAnalyticsWithContext is the same as GetCampaignAnalytics, but with a context for the request.
+func (r *AdAccountResource) GetCampaignAnalyticsWithContext(ctx context.Context, adAccountID string, args GetCampaignAnalyticsOpts) (AnalyticsResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/ad_accounts/" + adAccountID + "/campaigns/analytics"
 
 	var resp AnalyticsResponse
-	err := r.Cli.DoGet(path, args, &resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CampaignsBatchResponse represents the response for create or update campaigns.
+type CampaignsBatchResponse = BatchResponse[Campaign]
+
+// CreateCampaignOpts represents the parameters for create a campaign.
+type CreateCampaignOpts struct {
+	// AdAccountID is set by CreateCampaigns from the ad account id argument.
+	AdAccountID      string         `json:"ad_account_id"`
+	Name             string         `json:"name"`
+	ObjectiveType    ObjectiveType  `json:"objective_type"`
+	Status           EntityStatus   `json:"status,omitempty"`
+	LifetimeSpendCap *MicroCurrency `json:"lifetime_spend_cap,omitempty"`
+	DailySpendCap    *MicroCurrency `json:"daily_spend_cap,omitempty"`
+	OrderLineID      string         `json:"order_line_id,omitempty"`
+	TrackingURLs     *TrackingURLs  `json:"tracking_urls,omitempty"`
+	StartTime        *UnixTime      `json:"start_time,omitempty"`
+	EndTime          *UnixTime      `json:"end_time,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (c CreateCampaignOpts) Validate() error {
+	if c.Name == "" {
+		return &ValidationError{Field: "name", Message: "required"}
+	}
+	if c.ObjectiveType == "" {
+		return &ValidationError{Field: "objective_type", Message: "required"}
+	}
+	if err := validateEnum("objective_type", c.ObjectiveType); err != nil {
+		return err
+	}
+	if err := validateEnum("status", c.Status); err != nil {
+		return err
+	}
+	return validateSpendCaps(c.LifetimeSpendCap, c.DailySpendCap)
+}
+
+// UpdateCampaignOpts represents the parameters for update a campaign, only the set fields are changed.
+type UpdateCampaignOpts struct {
+	// AdAccountID is set by UpdateCampaigns from the ad account id argument.
+	AdAccountID      string         `json:"ad_account_id"`
+	ID               string         `json:"id"`
+	Name             *string        `json:"name,omitempty"`
+	Status           EntityStatus   `json:"status,omitempty"`
+	LifetimeSpendCap *MicroCurrency `json:"lifetime_spend_cap,omitempty"`
+	DailySpendCap    *MicroCurrency `json:"daily_spend_cap,omitempty"`
+	OrderLineID      *string        `json:"order_line_id,omitempty"`
+	TrackingURLs     *TrackingURLs  `json:"tracking_urls,omitempty"`
+	StartTime        *UnixTime      `json:"start_time,omitempty"`
+	EndTime          *UnixTime      `json:"end_time,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (u UpdateCampaignOpts) Validate() error {
+	if u.ID == "" {
+		return &ValidationError{Field: "id", Message: "required"}
+	}
+	if err := validateEnum("status", u.Status); err != nil {
+		return err
+	}
+	return validateSpendCaps(u.LifetimeSpendCap, u.DailySpendCap)
+}
+
+func validateSpendCaps(lifetime, daily *MicroCurrency) error {
+	if lifetime != nil && *lifetime < 0 {
+		return &ValidationError{Field: "lifetime_spend_cap", Message: "must not be negative"}
+	}
+	if daily != nil && *daily < 0 {
+		return &ValidationError{Field: "daily_spend_cap", Message: "must not be negative"}
+	}
+	return nil
+}
+
+// CreateCampaigns Create multiple new campaigns, every campaign requires an ad group and an ad to deliver.
+// The items may fail on their own, check the Errors of the response.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/campaigns/create
+func (r *AdAccountResource) CreateCampaigns(adAccountID string, args []CreateCampaignOpts) (*CampaignsBatchResponse, error) {
+	return r.CreateCampaignsWithContext(context.Background(), adAccountID, args)
+}
+
+// CreateCampaignsWithContext is the same as CreateCampaigns, but with a context for the request.
+func (r *AdAccountResource) CreateCampaignsWithContext(ctx context.Context, adAccountID string, args []CreateCampaignOpts) (*CampaignsBatchResponse, error) {
+	items := make([]CreateCampaignOpts, 0, len(args))
+	for i, opts := range args {
+		if err := opts.Validate(); err != nil {
+			return nil, batchValidationError(i, err)
+		}
+		opts.AdAccountID = adAccountID
+		items = append(items, opts)
+	}
+	path := "/ad_accounts/" + adAccountID + "/campaigns"
+
+	resp := new(CampaignsBatchResponse)
+	err := r.Cli.DoPostWithContext(ctx, path, items, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateCampaigns Update multiple campaigns.
+// The items may fail on their own, check the Errors of the response.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/campaigns/update
+func (r *AdAccountResource) UpdateCampaigns(adAccountID string, args []UpdateCampaignOpts) (*CampaignsBatchResponse, error) {
+	return r.UpdateCampaignsWithContext(context.Background(), adAccountID, args)
+}
+
+// UpdateCampaignsWithContext is the same as UpdateCampaigns, but with a context for the request.
+func (r *AdAccountResource) UpdateCampaignsWithContext(ctx context.Context, adAccountID string, args []UpdateCampaignOpts) (*CampaignsBatchResponse, error) {
+	items := make([]UpdateCampaignOpts, 0, len(args))
+	for i, opts := range args {
+		if err := opts.Validate(); err != nil {
+			return nil, batchValidationError(i, err)
+		}
+		opts.AdAccountID = adAccountID
+		items = append(items, opts)
+	}
+	path := "/ad_accounts/" + adAccountID + "/campaigns"
+
+	resp := new(CampaignsBatchResponse)
+	err := r.Cli.DoPatchWithContext(ctx, path, items, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetCampaign Get a specific campaign given the campaign ID.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/campaigns/get
+func (r *AdAccountResource) GetCampaign(adAccountID, campaignID string) (*Campaign, error) {
+	return r.GetCampaignWithContext(context.Background(), adAccountID, campaignID)
+}
+
+// GetCampaignWithContext is the same as GetCampaign, but with a context for the request.
+func (r *AdAccountResource) GetCampaignWithContext(ctx context.Context, adAccountID, campaignID string) (*Campaign, error) {
+	path := "/ad_accounts/" + adAccountID + "/campaigns/" + campaignID
+
+	resp := new(Campaign)
+	err := r.Cli.DoGetWithContext(ctx, path, nil, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
+
+// setCampaignsStatus Update the status of the campaigns.
+func (r *AdAccountResource) setCampaignsStatus(ctx context.Context, adAccountID string, status EntityStatus, campaignIDs []string) (*CampaignsBatchResponse, error) {
+	args := make([]UpdateCampaignOpts, 0, len(campaignIDs))
+	for _, id := range campaignIDs {
+		args = append(args, UpdateCampaignOpts{ID: id, Status: status})
+	}
+	return r.UpdateCampaignsWithContext(ctx, adAccountID, args)
+}
+
+// PauseCampaigns Pause the campaigns.
+func (r *AdAccountResource) PauseCampaigns(adAccountID string, campaignIDs ...string) (*CampaignsBatchResponse, error) {
+	return r.PauseCampaignsWithContext(context.Background(), adAccountID, campaignIDs...)
+}
+
+// PauseCampaignsWithContext is the same as PauseCampaigns, but with a context for the request.
+func (r *AdAccountResource) PauseCampaignsWithContext(ctx context.Context, adAccountID string, campaignIDs ...string) (*CampaignsBatchResponse, error) {
+	return r.setCampaignsStatus(ctx, adAccountID, EntityStatusPaused, campaignIDs)
+}
+
+// ResumeCampaigns Activate the paused campaigns.
+func (r *AdAccountResource) ResumeCampaigns(adAccountID string, campaignIDs ...string) (*CampaignsBatchResponse, error) {
+	return r.ResumeCampaignsWithContext(context.Background(), adAccountID, campaignIDs...)
+}
+
+// ResumeCampaignsWithContext is the same as ResumeCampaigns, but with a context for the request.
+func (r *AdAccountResource) ResumeCampaignsWithContext(ctx context.Context, adAccountID string, campaignIDs ...string) (*CampaignsBatchResponse, error) {
+	return r.setCampaignsStatus(ctx, adAccountID, EntityStatusActive, campaignIDs)
+}
+
+// ArchiveCampaigns Archive the campaigns, the archived campaigns can not be activated again.
+func (r *AdAccountResource) ArchiveCampaigns(adAccountID string, campaignIDs ...string) (*CampaignsBatchResponse, error) {
+	return r.ArchiveCampaignsWithContext(context.Background(), adAccountID, campaignIDs...)
+}
+
+// ArchiveCampaignsWithContext is the same as ArchiveCampaigns, but with a context for the request.
+func (r *AdAccountResource) ArchiveCampaignsWithContext(ctx context.Context, adAccountID string, campaignIDs ...string) (*CampaignsBatchResponse, error) {
+	return r.setCampaignsStatus(ctx, adAccountID, EntityStatusArchived, campaignIDs)
+}
@@ -1,7 +1,11 @@
 package pinterest
 
 import (
+	"encoding/json"
+	"errors"
 	"github.com/jarcoal/httpmock"
+	"io"
+	"net/http"
 )
 
 func (bc *BCSuite) TestListCampaigns() {
@@ -50,5 +54,110 @@ func (bc *BCSuite) TestGetCampaignAnalytics() {
 	)
 
 	analytics, _ := bc.Pin.AdAccount.GetCampaignAnalytics(adAccountID, GetCampaignAnalyticsOpts{})
-	bc.Equal(analytics[0]["DATE"], "2021-04-01")
+	bc.Equal(analytics[0].Date.Format(DateLayout), "2021-04-01")
+}
+
+func (bc *BCSuite) TestCreateCampaigns() {
+	adAccountID := "549755885175"
+	_, err := bc.Pin.AdAccount.CreateCampaigns(adAccountID, []CreateCampaignOpts{{Name: "ACME Tools", ObjectiveType: ObjectiveAwareness}, {Name: "ACME Toys"}})
+	bc.IsType(&ValidationError{}, err)
+	bc.Equal("items[1].objective_type", err.(*ValidationError).Field)
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/"+adAccountID+"/campaigns",
+		httpmock.NewStringResponder(
+			400,
+			`{"code":400,"message":"Invalid ad account campaign parameters."}`,
+		),
+	)
+	_, err = bc.Pin.AdAccount.CreateCampaigns(adAccountID, []CreateCampaignOpts{{Name: "ACME Tools", ObjectiveType: ObjectiveAwareness}})
+	bc.IsType(&APIError{}, err)
+
+	var sent []map[string]interface{}
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/"+adAccountID+"/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &sent)
+			return httpmock.NewStringResponse(
+				200,
+				`{"items":[{"data":{"id":"549755885176","ad_account_id":"549755885175","name":"ACME Tools","status":"PAUSED","daily_spend_cap":1500000,"objective_type":"AWARENESS"},"exceptions":[]},{"data":null,"exceptions":[{"code":2,"message":"Advertiser not found."}]}]}`,
+			), nil
+		},
+	)
+
+	campaigns, err := bc.Pin.AdAccount.CreateCampaigns(adAccountID, []CreateCampaignOpts{
+		{Name: "ACME Tools", ObjectiveType: ObjectiveAwareness, Status: EntityStatusPaused, DailySpendCap: Ptr(Micros(1.5))},
+		{Name: "ACME Toys", ObjectiveType: ObjectiveAwareness},
+	})
+	bc.Nil(err)
+	bc.Equal(adAccountID, sent[1]["ad_account_id"])
+	bc.Equal(float64(1500000), sent[0]["daily_spend_cap"])
+	bc.Nil(campaigns.Err(0))
+	bc.Equal(1.5, campaigns.Items[0].Data.DailySpendCap.Amount())
+	bc.Len(campaigns.Succeeded(), 1)
+
+	var batchErr *BatchError
+	bc.True(errors.As(campaigns.Errors(), &batchErr))
+	bc.Len(batchErr.Errors, 1)
+	bc.Equal(1, batchErr.Errors[0].Index)
+	bc.Equal("Advertiser not found.", batchErr.Errors[0].Message)
+}
+
+func (bc *BCSuite) TestUpdateCampaigns() {
+	adAccountID := "549755885175"
+	_, err := bc.Pin.AdAccount.UpdateCampaigns(adAccountID, []UpdateCampaignOpts{{ID: "549755885176", DailySpendCap: Ptr(MicroCurrency(-1))}})
+	bc.IsType(&ValidationError{}, err)
+
+	var sent []map[string]interface{}
+	httpmock.RegisterResponder(
+		HttpPatch, Baseurl+"/ad_accounts/"+adAccountID+"/campaigns",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &sent)
+			return httpmock.NewStringResponse(
+				200,
+				`{"items":[{"data":{"id":"549755885176","status":"PAUSED"},"exceptions":[]},{"data":{"id":"549755885177","status":"PAUSED"},"exceptions":[]}]}`,
+			), nil
+		},
+	)
+
+	campaigns, err := bc.Pin.AdAccount.PauseCampaigns(adAccountID, "549755885176", "549755885177")
+	bc.Nil(err)
+	bc.Nil(campaigns.Errors())
+	bc.Len(sent, 2)
+	bc.Equal(map[string]interface{}{"ad_account_id": adAccountID, "id": "549755885177", "status": "PAUSED"}, sent[1])
+	bc.Equal(EntityStatusPaused, *campaigns.Items[1].Data.Status)
+
+	_, _ = bc.Pin.AdAccount.ResumeCampaigns(adAccountID, "549755885176")
+	bc.Equal("ACTIVE", sent[0]["status"])
+	_, _ = bc.Pin.AdAccount.ArchiveCampaigns(adAccountID, "549755885176")
+	bc.Equal("ARCHIVED", sent[0]["status"])
+}
+
+func (bc *BCSuite) TestGetCampaign() {
+	adAccountID := "549755885175"
+	campaignID := "549755885176"
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/"+adAccountID+"/campaigns/"+campaignID,
+		httpmock.NewStringResponder(
+			404,
+			`{"code":404,"message":"Campaign not found."}`,
+		),
+	)
+	_, err := bc.Pin.AdAccount.GetCampaign(adAccountID, campaignID)
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/"+adAccountID+"/campaigns/"+campaignID,
+		httpmock.NewStringResponder(
+			200,
+			`{"id":"549755885176","ad_account_id":"549755885175","name":"ACME Tools","status":"ACTIVE","lifetime_spend_cap":1432744744,"objective_type":"AWARENESS","start_time":1580865126}`,
+		),
+	)
+
+	campaign, _ := bc.Pin.AdAccount.GetCampaign(adAccountID, campaignID)
+	bc.Equal(*campaign.ID, campaignID)
+	bc.Equal(MicroCurrency(1432744744), *campaign.LifetimeSpendCap)
+	bc.Equal(ObjectiveAwareness, *campaign.ObjectiveType)
 }
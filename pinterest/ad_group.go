@@ -1,30 +1,35 @@
 package pinterest
 
+import (
+	"context"
+	"errors"
+)
+
 // AdGroup represents the ad group info.
 type AdGroup struct {
-	ID                         *string              `json:"id"`
-	Type                       *string              `json:"type"`
-	AdAccountID                *string              `json:"ad_account_id"`
-	Name                       *string              `json:"name"`
-	Status                     *string              `json:"status"`
-	BudgetInMicroCurrency      *int                 `json:"budget_in_micro_currency"`
-	BidInMicroCurrency         *int                 `json:"bid_in_micro_currency"`
-	BudgetType                 *string              `json:"budget_type"`
-	StartTime                  *int                 `json:"start_time"`
-	EndTime                    *int                 `json:"end_time"`
-	TargetingSpec              *map[string][]string `json:"targeting_spec"`
-	LifetimeFrequencyCap       *int                 `json:"lifetime_frequency_cap"`
-	TrackingURLs               *TrackingURLs        `json:"tracking_urls"`
-	AutoTargetingEnabled       *bool                `json:"auto_targeting_enabled"`
-	PlacementGroup             *string              `json:"placement_group"`
-	PacingDeliveryType         *string              `json:"pacing_delivery_type"`
-	ConversionLearningModeType *string              `json:"conversion_learning_mode_type"`
-	SummaryStatus              *string              `json:"summary_status"`
-	FeedProfileID              *string              `json:"feed_profile_id"`
-	CampaignID                 *string              `json:"campaign_id"`
-	BillableEvent              *string              `json:"billable_event"`
-	CreatedTime                *int                 `json:"created_time"`
-	UpdatedTime                *int                 `json:"updated_time"`
+	ID                         *string        `json:"id"`
+	Type                       *string        `json:"type"`
+	AdAccountID                *string        `json:"ad_account_id"`
+	Name                       *string        `json:"name"`
+	Status                     *EntityStatus  `json:"status"`
+	BudgetInMicroCurrency      *MicroCurrency `json:"budget_in_micro_currency"`
+	BidInMicroCurrency         *MicroCurrency `json:"bid_in_micro_currency"`
+	BudgetType                 *BudgetType    `json:"budget_type"`
+	StartTime                  *UnixTime      `json:"start_time"`
+	EndTime                    *UnixTime      `json:"end_time"`
+	TargetingSpec              *TargetingSpec `json:"targeting_spec"`
+	LifetimeFrequencyCap       *int           `json:"lifetime_frequency_cap"`
+	TrackingURLs               *TrackingURLs  `json:"tracking_urls"`
+	AutoTargetingEnabled       *bool          `json:"auto_targeting_enabled"`
+	PlacementGroup             *string        `json:"placement_group"`
+	PacingDeliveryType         *string        `json:"pacing_delivery_type"`
+	ConversionLearningModeType *string        `json:"conversion_learning_mode_type"`
+	SummaryStatus              *string        `json:"summary_status"`
+	FeedProfileID              *string        `json:"feed_profile_id"`
+	CampaignID                 *string        `json:"campaign_id"`
+	BillableEvent              *BillableEvent `json:"billable_event"`
+	CreatedTime                *UnixTime      `json:"created_time"`
+	UpdatedTime                *UnixTime      `json:"updated_time"`
 }
 
 func (a AdGroup) String() string {
@@ -43,47 +48,254 @@ func (c AdGroupsResponse) String() string {
 
 // ListAdGroupsOpts represents the parameters for list ad groups.
 type ListAdGroupsOpts struct {
-	CampaignIDs               []string `url:"campaign_ids"`
-	AdGroupIDs                []string `url:"ad_group_ids"`
-	EntityStatuses            []string `url:"entity_statuses"`
-	Order                     string   `url:"order"`
-	TranslateInterestsToNames bool     `url:"translate_interests_to_names"`
+	CampaignIDs               []string       `url:"campaign_ids"`
+	AdGroupIDs                []string       `url:"ad_group_ids"`
+	EntityStatuses            []EntityStatus `url:"entity_statuses"`
+	Order                     string         `url:"order"`
+	TranslateInterestsToNames bool           `url:"translate_interests_to_names"`
 	ListOptions
 }
 
+// Validate Check the parameters before sending the request.
+func (l ListAdGroupsOpts) Validate() error {
+	return validateEnums("entity_statuses", l.EntityStatuses)
+}
+
 // ListAdGroups Get a list of the ad groups in the specified ad_account_id, filtered by the specified options.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/ad_groups/list
-func (r *AdAccountResource) ListAdGroups(adAccountID string, args ListAdGroupsOpts) (*AdGroupsResponse, *APIError) {
+func (r *AdAccountResource) ListAdGroups(adAccountID string, args ListAdGroupsOpts) (*AdGroupsResponse, error) {
+	return r.ListAdGroupsWithContext(context.Background(), adAccountID, args)
+}
+
+// ListAdGroupsWithContext is the same as ListAdGroups, but with a context for the request.
+func (r *AdAccountResource) ListAdGroupsWithContext(ctx context.Context, adAccountID string, args ListAdGroupsOpts) (*AdGroupsResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/ad_accounts/" + adAccountID + "/ad_groups"
 
 	resp := new(AdGroupsResponse)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// ListAdGroupsIter Return an iterator walking through all the ad groups, the pages are fetched by the bookmark.
+func (r *AdAccountResource) ListAdGroupsIter(adAccountID string, args ListAdGroupsOpts) *Iterator[*AdGroup] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*AdGroup, *string, error) {
+		args.ListOptions = opts
+		resp, err := r.ListAdGroupsWithContext(ctx, adAccountID, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args.ListOptions)
+}
+
 // GetAdGroupAnalyticsOpts represents the parameters for Get ad group analytics.
 type GetAdGroupAnalyticsOpts struct {
-	StartDate            string   `url:"start_date"`
-	EndDate              string   `url:"end_date"`
-	AdGroupIDs           []string `url:"ad_group_ids"`
-	Columns              []string `url:"columns"`
-	Granularity          string   `url:"granularity"`
-	ClickWindowDays      int      `url:"click_window_days,omitempty"`
-	EngagementWindowDays int      `url:"engagement_window_days,omitempty"`
-	ViewWindowDays       int      `url:"view_window_days,omitempty"`
-	ConversionReportTime string   `url:"conversion_report_time,omitempty"`
+	StartDate            string            `url:"start_date"`
+	EndDate              string            `url:"end_date"`
+	AdGroupIDs           []string          `url:"ad_group_ids"`
+	Columns              []AnalyticsColumn `url:"columns"`
+	Granularity          Granularity       `url:"granularity"`
+	ClickWindowDays      int               `url:"click_window_days,omitempty"`
+	EngagementWindowDays int               `url:"engagement_window_days,omitempty"`
+	ViewWindowDays       int               `url:"view_window_days,omitempty"`
+	ConversionReportTime string            `url:"conversion_report_time,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (g GetAdGroupAnalyticsOpts) Validate() error {
+	return validateAnalytics(g.Columns, g.Granularity)
 }
 
 // GetAdGroupAnalytics Get analytics for the specified campaigns in the specified ad_account_id, filtered by the specified options.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/ad_groups/analytics
-func (r *AdAccountResource) GetAdGroupAnalytics(adAccountID string, args GetAdGroupAnalyticsOpts) (AnalyticsResponse, *APIError) {
+func (r *AdAccountResource) GetAdGroupAnalytics(adAccountID string, args GetAdGroupAnalyticsOpts) (AnalyticsResponse, error) {
+	return r.GetAdGroupAnalyticsWithContext(context.Background(), adAccountID, args)
+}
+
+// GetAdGroupAnalyticsWithContext is the same as GetAdGroupAnalytics, but with a context for the request.
+func (r *AdAccountResource) GetAdGroupAnalyticsWithContext(ctx context.Context, adAccountID string, args GetAdGroupAnalyticsOpts) (AnalyticsResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/ad_accounts/" + adAccountID + "/ad_groups/analytics"
 
 	var resp AnalyticsResponse
-	err := r.Cli.DoGet(path, args, &resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AdGroupsBatchResponse represents the response for create or update ad groups.
+type AdGroupsBatchResponse = BatchResponse[AdGroup]
+
+// CreateAdGroupOpts represents the parameters for create an ad group.
+type CreateAdGroupOpts struct {
+	// AdAccountID is set by CreateAdGroups from the ad account id argument.
+	AdAccountID           string         `json:"ad_account_id"`
+	CampaignID            string         `json:"campaign_id"`
+	Name                  string         `json:"name"`
+	BillableEvent         BillableEvent  `json:"billable_event"`
+	Status                EntityStatus   `json:"status,omitempty"`
+	BudgetInMicroCurrency *MicroCurrency `json:"budget_in_micro_currency,omitempty"`
+	BidInMicroCurrency    *MicroCurrency `json:"bid_in_micro_currency,omitempty"`
+	BudgetType            BudgetType     `json:"budget_type,omitempty"`
+	StartTime             *UnixTime      `json:"start_time,omitempty"`
+	EndTime               *UnixTime      `json:"end_time,omitempty"`
+	TargetingSpec         *TargetingSpec `json:"targeting_spec,omitempty"`
+	LifetimeFrequencyCap  int            `json:"lifetime_frequency_cap,omitempty"`
+	TrackingURLs          *TrackingURLs  `json:"tracking_urls,omitempty"`
+	AutoTargetingEnabled  *bool          `json:"auto_targeting_enabled,omitempty"`
+	PlacementGroup        string         `json:"placement_group,omitempty"`
+	PacingDeliveryType    string         `json:"pacing_delivery_type,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (c CreateAdGroupOpts) Validate() error {
+	if c.CampaignID == "" {
+		return &ValidationError{Field: "campaign_id", Message: "required"}
+	}
+	if c.Name == "" {
+		return &ValidationError{Field: "name", Message: "required"}
+	}
+	if c.BillableEvent == "" {
+		return &ValidationError{Field: "billable_event", Message: "required"}
+	}
+	if err := validateEnum("billable_event", c.BillableEvent); err != nil {
+		return err
+	}
+	return validateAdGroup(c.Status, c.BudgetType, c.BudgetInMicroCurrency, c.BidInMicroCurrency, c.StartTime, c.EndTime, c.TargetingSpec)
+}
+
+// UpdateAdGroupOpts represents the parameters for update an ad group, only the set fields are changed.
+type UpdateAdGroupOpts struct {
+	// AdAccountID is set by UpdateAdGroups from the ad account id argument.
+	AdAccountID           string         `json:"ad_account_id"`
+	ID                    string         `json:"id"`
+	Name                  *string        `json:"name,omitempty"`
+	Status                EntityStatus   `json:"status,omitempty"`
+	BudgetInMicroCurrency *MicroCurrency `json:"budget_in_micro_currency,omitempty"`
+	BidInMicroCurrency    *MicroCurrency `json:"bid_in_micro_currency,omitempty"`
+	BudgetType            BudgetType     `json:"budget_type,omitempty"`
+	StartTime             *UnixTime      `json:"start_time,omitempty"`
+	EndTime               *UnixTime      `json:"end_time,omitempty"`
+	TargetingSpec         *TargetingSpec `json:"targeting_spec,omitempty"`
+	LifetimeFrequencyCap  *int           `json:"lifetime_frequency_cap,omitempty"`
+	TrackingURLs          *TrackingURLs  `json:"tracking_urls,omitempty"`
+	AutoTargetingEnabled  *bool          `json:"auto_targeting_enabled,omitempty"`
+	PlacementGroup        *string        `json:"placement_group,omitempty"`
+	PacingDeliveryType    *string        `json:"pacing_delivery_type,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (u UpdateAdGroupOpts) Validate() error {
+	if u.ID == "" {
+		return &ValidationError{Field: "id", Message: "required"}
+	}
+	return validateAdGroup(u.Status, u.BudgetType, u.BudgetInMicroCurrency, u.BidInMicroCurrency, u.StartTime, u.EndTime, u.TargetingSpec)
+}
+
+func validateAdGroup(status EntityStatus, budgetType BudgetType, budget, bid *MicroCurrency, start, end *UnixTime, spec *TargetingSpec) error {
+	if err := validateEnum("status", status); err != nil {
+		return err
+	}
+	if err := validateEnum("budget_type", budgetType); err != nil {
+		return err
+	}
+	if budget != nil && *budget < 0 {
+		return &ValidationError{Field: "budget_in_micro_currency", Message: "must not be negative"}
+	}
+	if bid != nil && *bid < 0 {
+		return &ValidationError{Field: "bid_in_micro_currency", Message: "must not be negative"}
+	}
+	if start != nil && end != nil && !end.After(start.Time) {
+		return &ValidationError{Field: "end_time", Message: "must be after the start_time"}
+	}
+	if spec != nil {
+		if err := spec.Validate(); err != nil {
+			var vErr *ValidationError
+			if errors.As(err, &vErr) {
+				return &ValidationError{Field: "targeting_spec." + vErr.Field, Message: vErr.Message}
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateAdGroups Create multiple new ad groups. All ads in a given ad group will have the same budget, bid, run dates, targeting, and placement.
+// The items may fail on their own, check the Errors of the response.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/ad_groups/create
+func (r *AdAccountResource) CreateAdGroups(adAccountID string, args []CreateAdGroupOpts) (*AdGroupsBatchResponse, error) {
+	return r.CreateAdGroupsWithContext(context.Background(), adAccountID, args)
+}
+
+// CreateAdGroupsWithContext is the same as CreateAdGroups, but with a context for the request.
+func (r *AdAccountResource) CreateAdGroupsWithContext(ctx context.Context, adAccountID string, args []CreateAdGroupOpts) (*AdGroupsBatchResponse, error) {
+	items := make([]CreateAdGroupOpts, 0, len(args))
+	for i, opts := range args {
+		if err := opts.Validate(); err != nil {
+			return nil, batchValidationError(i, err)
+		}
+		opts.AdAccountID = adAccountID
+		items = append(items, opts)
+	}
+	path := "/ad_accounts/" + adAccountID + "/ad_groups"
+
+	resp := new(AdGroupsBatchResponse)
+	err := r.Cli.DoPostWithContext(ctx, path, items, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateAdGroups Update multiple existing ad groups.
+// The items may fail on their own, check the Errors of the response.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/ad_groups/update
+func (r *AdAccountResource) UpdateAdGroups(adAccountID string, args []UpdateAdGroupOpts) (*AdGroupsBatchResponse, error) {
+	return r.UpdateAdGroupsWithContext(context.Background(), adAccountID, args)
+}
+
+// UpdateAdGroupsWithContext is the same as UpdateAdGroups, but with a context for the request.
+func (r *AdAccountResource) UpdateAdGroupsWithContext(ctx context.Context, adAccountID string, args []UpdateAdGroupOpts) (*AdGroupsBatchResponse, error) {
+	items := make([]UpdateAdGroupOpts, 0, len(args))
+	for i, opts := range args {
+		if err := opts.Validate(); err != nil {
+			return nil, batchValidationError(i, err)
+		}
+		opts.AdAccountID = adAccountID
+		items = append(items, opts)
+	}
+	path := "/ad_accounts/" + adAccountID + "/ad_groups"
+
+	resp := new(AdGroupsBatchResponse)
+	err := r.Cli.DoPatchWithContext(ctx, path, items, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetAdGroup Get a specific ad group given the ad group ID.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/ad_groups/get
+func (r *AdAccountResource) GetAdGroup(adAccountID, adGroupID string) (*AdGroup, error) {
+	return r.GetAdGroupWithContext(context.Background(), adAccountID, adGroupID)
+}
+
+// GetAdGroupWithContext is the same as GetAdGroup, but with a context for the request.
+func (r *AdAccountResource) GetAdGroupWithContext(ctx context.Context, adAccountID, adGroupID string) (*AdGroup, error) {
+	path := "/ad_accounts/" + adAccountID + "/ad_groups/" + adGroupID
+
+	resp := new(AdGroup)
+	err := r.Cli.DoGetWithContext(ctx, path, nil, resp)
 	if err != nil {
 		return nil, err
 	}
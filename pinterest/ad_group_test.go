@@ -1,7 +1,11 @@
 package pinterest
 
 import (
+	"encoding/json"
 	"github.com/jarcoal/httpmock"
+	"io"
+	"net/http"
+	"time"
 )
 
 func (bc *BCSuite) TestListAdGroups() {
@@ -50,5 +54,107 @@ func (bc *BCSuite) TestGetAdGroupAnalytics() {
 	)
 
 	analytics, _ := bc.Pin.AdAccount.GetAdGroupAnalytics(adAccountID, GetAdGroupAnalyticsOpts{})
-	bc.Equal(analytics[0]["DATE"], "2021-04-01")
+	bc.Equal(analytics[0].Date.Format(DateLayout), "2021-04-01")
+}
+
+func (bc *BCSuite) TestCreateAdGroups() {
+	adAccountID := "549755885175"
+	spec, _ := NewTargetingSpecBuilder().Genders(GenderFemale).Locations("US").Build()
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	opts := CreateAdGroupOpts{
+		CampaignID:            "626736533506",
+		Name:                  "Spring",
+		BillableEvent:         BillableEventClickthrough,
+		BudgetType:            BudgetTypeDaily,
+		BudgetInMicroCurrency: Ptr(Micros(5)),
+		StartTime:             NewUnixTime(start),
+		EndTime:               NewUnixTime(start.AddDate(0, 1, 0)),
+		TargetingSpec:         spec,
+	}
+
+	bad := opts
+	bad.EndTime = NewUnixTime(start)
+	_, err := bc.Pin.AdAccount.CreateAdGroups(adAccountID, []CreateAdGroupOpts{bad})
+	bc.Equal("items[0].end_time", err.(*ValidationError).Field)
+
+	bad = opts
+	bad.TargetingSpec = &TargetingSpec{Gender: []Gender{"woman"}}
+	_, err = bc.Pin.AdAccount.CreateAdGroups(adAccountID, []CreateAdGroupOpts{bad})
+	bc.Equal("items[0].targeting_spec.GENDER[0]", err.(*ValidationError).Field)
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/"+adAccountID+"/ad_groups",
+		httpmock.NewStringResponder(
+			400,
+			`{"code":400,"message":"Invalid ad group parameters."}`,
+		),
+	)
+	_, err = bc.Pin.AdAccount.CreateAdGroups(adAccountID, []CreateAdGroupOpts{opts})
+	bc.IsType(&APIError{}, err)
+
+	var sent []map[string]interface{}
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/"+adAccountID+"/ad_groups",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &sent)
+			return httpmock.NewStringResponse(
+				200,
+				`{"items":[{"data":{"id":"2680060704746","name":"Spring","campaign_id":"626736533506","budget_in_micro_currency":5000000,"targeting_spec":{"GENDER":["female"],"LOCATION":["US"]}},"exceptions":[]}]}`,
+			), nil
+		},
+	)
+
+	adGroups, err := bc.Pin.AdAccount.CreateAdGroups(adAccountID, []CreateAdGroupOpts{opts})
+	bc.Nil(err)
+	bc.Equal(adAccountID, sent[0]["ad_account_id"])
+	bc.Equal(float64(start.Unix()), sent[0]["start_time"])
+	bc.Equal(map[string]interface{}{"GENDER": []interface{}{"female"}, "LOCATION": []interface{}{"US"}}, sent[0]["targeting_spec"])
+	bc.Nil(adGroups.Errors())
+	bc.Equal([]Gender{GenderFemale}, adGroups.Items[0].Data.TargetingSpec.Gender)
+}
+
+func (bc *BCSuite) TestUpdateAdGroups() {
+	adAccountID := "549755885175"
+	_, err := bc.Pin.AdAccount.UpdateAdGroups(adAccountID, []UpdateAdGroupOpts{{Name: String("Summer")}})
+	bc.Equal("items[0].id", err.(*ValidationError).Field)
+
+	httpmock.RegisterResponder(
+		HttpPatch, Baseurl+"/ad_accounts/"+adAccountID+"/ad_groups",
+		httpmock.NewStringResponder(
+			200,
+			`{"items":[{"data":null,"exceptions":[{"code":2603,"message":"Ad group not found."}]}]}`,
+		),
+	)
+	adGroups, err := bc.Pin.AdAccount.UpdateAdGroups(adAccountID, []UpdateAdGroupOpts{{ID: "2680060704746", Name: String("Summer")}})
+	bc.Nil(err)
+	bc.Len(adGroups.Succeeded(), 0)
+	bc.Equal(2603, adGroups.Err(0).(*BatchItemError).Code)
+}
+
+func (bc *BCSuite) TestGetAdGroup() {
+	adAccountID := "549755885175"
+	adGroupID := "2680060704746"
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/"+adAccountID+"/ad_groups/"+adGroupID,
+		httpmock.NewStringResponder(
+			404,
+			`{"code":404,"message":"Ad group not found."}`,
+		),
+	)
+	_, err := bc.Pin.AdAccount.GetAdGroup(adAccountID, adGroupID)
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/"+adAccountID+"/ad_groups/"+adGroupID,
+		httpmock.NewStringResponder(
+			200,
+			`{"id":"2680060704746","name":"Spring","billable_event":"CLICKTHROUGH","bid_in_micro_currency":1200000,"targeting_spec":{"AGE_BUCKET":["25-34"]}}`,
+		),
+	)
+
+	adGroup, _ := bc.Pin.AdAccount.GetAdGroup(adAccountID, adGroupID)
+	bc.Equal(BillableEventClickthrough, *adGroup.BillableEvent)
+	bc.Equal(1.2, adGroup.BidInMicroCurrency.Amount())
+	bc.Equal([]AgeBucket{AgeBucket25To34}, adGroup.TargetingSpec.AgeBucket)
 }
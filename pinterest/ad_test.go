@@ -1,7 +1,10 @@
 package pinterest
 
 import (
+	"encoding/json"
 	"github.com/jarcoal/httpmock"
+	"io"
+	"net/http"
 )
 
 func (bc *BCSuite) TestListAds() {
@@ -51,7 +54,7 @@ func (bc *BCSuite) TestGetAdAnalytics() {
 	)
 
 	analytics, _ := bc.Pin.AdAccount.GetAdAnalytics(adAccountID, GetAdAnalyticsOpts{})
-	bc.Equal(analytics[0]["DATE"], "2021-04-01")
+	bc.Equal(analytics[0].Date.Format(DateLayout), "2021-04-01")
 }
 
 func (bc *BCSuite) TestGetProductGroupAnalytics() {
@@ -75,5 +78,126 @@ func (bc *BCSuite) TestGetProductGroupAnalytics() {
 	)
 
 	analytics, _ := bc.Pin.AdAccount.GetProductGroupAnalytics(adAccountID, GetProductGroupAnalyticsOpts{})
-	bc.Equal(analytics[0]["DATE"], "2021-04-01")
+	bc.Equal(analytics[0].Date.Format(DateLayout), "2021-04-01")
+}
+
+func (bc *BCSuite) TestCreateAds() {
+	adAccountID := "549755885175"
+	_, err := bc.Pin.AdAccount.CreateAds(adAccountID, []CreateAdOpts{{AdGroupID: "2680059592705", PinID: "394205773611545468", CreativeType: "PHOTO"}})
+	bc.Equal("items[0].creative_type", err.(*ValidationError).Field)
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/"+adAccountID+"/ads",
+		httpmock.NewStringResponder(
+			400,
+			`{"code":400,"message":"Invalid ad parameters."}`,
+		),
+	)
+	opts := []CreateAdOpts{
+		{AdGroupID: "2680059592705", PinID: "394205773611545468", CreativeType: CreativeTypeRegular},
+		{AdGroupID: "2680059592705", PinID: "394205773611545469", CreativeType: CreativeTypeRegular},
+		{AdGroupID: "2680059592705", PinID: "394205773611545470", CreativeType: CreativeTypeVideo},
+	}
+	_, err = bc.Pin.AdAccount.CreateAds(adAccountID, opts)
+	bc.IsType(&APIError{}, err)
+
+	var sent []map[string]interface{}
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/"+adAccountID+"/ads",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &sent)
+			return httpmock.NewStringResponse(
+				200,
+				`{"items":[{"data":{"id":"687195134316","pin_id":"394205773611545468","review_status":"PENDING"},"exceptions":[]},{"data":{"id":"687195134317","pin_id":"394205773611545469","review_status":"REJECTED","rejected_reasons":["HASHTAGS","LANDING_PAGE_QUALITY"],"rejection_labels":["Hashtags in description"]},"exceptions":[]},{"data":null,"exceptions":[{"code":2,"message":"Pin is not a video pin."}]}]}`,
+			), nil
+		},
+	)
+
+	ads, err := bc.Pin.AdAccount.CreateAds(adAccountID, opts)
+	bc.Nil(err)
+	bc.Equal(adAccountID, sent[0]["ad_account_id"])
+	bc.Equal("VIDEO", sent[2]["creative_type"])
+	bc.Len(ads.Succeeded(), 2)
+	bc.Equal(2, ads.Errors().(*BatchError).Errors[0].Index)
+
+	rejections := ads.Rejections()
+	bc.Len(rejections, 1)
+	bc.Equal(1, rejections[0].Index)
+	bc.Equal("687195134317", rejections[0].AdID)
+	bc.Equal([]string{"HASHTAGS", "LANDING_PAGE_QUALITY"}, rejections[0].Reasons)
+	bc.Equal([]string{"Hashtags in description"}, rejections[0].Labels)
+}
+
+func (bc *BCSuite) TestUpdateAds() {
+	adAccountID := "549755885175"
+	_, err := bc.Pin.AdAccount.UpdateAds(adAccountID, []UpdateAdOpts{{Status: EntityStatusPaused}})
+	bc.Equal("items[0].id", err.(*ValidationError).Field)
+
+	httpmock.RegisterResponder(
+		HttpPatch, Baseurl+"/ad_accounts/"+adAccountID+"/ads",
+		httpmock.NewStringResponder(
+			200,
+			`{"items":[{"data":{"id":"687195134316","status":"PAUSED","review_status":"APPROVED"},"exceptions":[]}]}`,
+		),
+	)
+	ads, err := bc.Pin.AdAccount.UpdateAds(adAccountID, []UpdateAdOpts{{ID: "687195134316", Status: EntityStatusPaused}})
+	bc.Nil(err)
+	bc.Nil(ads.Errors())
+	bc.Len(ads.Rejections(), 0)
+	bc.Equal(AdReviewStatusApproved, *ads.Items[0].Data.ReviewStatus)
+}
+
+func (bc *BCSuite) TestGetAd() {
+	adAccountID := "549755885175"
+	adID := "687195134316"
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/"+adAccountID+"/ads/"+adID,
+		httpmock.NewStringResponder(
+			404,
+			`{"code":404,"message":"Ad not found."}`,
+		),
+	)
+	_, err := bc.Pin.AdAccount.GetAd(adAccountID, adID)
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/"+adAccountID+"/ads/"+adID,
+		httpmock.NewStringResponder(
+			200,
+			`{"id":"687195134316","creative_type":"REGULAR","review_status":"REJECTED","rejected_reasons":["HASHTAGS"]}`,
+		),
+	)
+
+	ad, _ := bc.Pin.AdAccount.GetAd(adAccountID, adID)
+	bc.Equal(CreativeTypeRegular, *ad.CreativeType)
+	bc.True(ad.IsRejected())
+}
+
+func (bc *BCSuite) TestCreateAdPreview() {
+	adAccountID := "549755885175"
+	_, err := bc.Pin.AdAccount.CreateAdPreview(adAccountID, CreateAdPreviewOpts{ImageURL: "https://i.pinimg.com/1.jpg"})
+	bc.Equal("title", err.(*ValidationError).Field)
+	_, err = bc.Pin.AdAccount.CreateAdPreview(adAccountID, CreateAdPreviewOpts{})
+	bc.Equal("pin_id", err.(*ValidationError).Field)
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/"+adAccountID+"/ad_previews",
+		httpmock.NewStringResponder(
+			400,
+			`{"code":400,"message":"Invalid pin."}`,
+		),
+	)
+	_, err = bc.Pin.AdAccount.CreateAdPreview(adAccountID, CreateAdPreviewOpts{PinID: "394205773611545468"})
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/"+adAccountID+"/ad_previews",
+		httpmock.NewStringResponder(
+			200,
+			`{"url":"https://ads.pinterest.com/ad-preview/0123456789abcdef/"}`,
+		),
+	)
+	preview, _ := bc.Pin.AdAccount.CreateAdPreview(adAccountID, CreateAdPreviewOpts{ImageURL: "https://i.pinimg.com/1.jpg", Title: "Garden tools"})
+	bc.Equal("https://ads.pinterest.com/ad-preview/0123456789abcdef/", *preview.URL)
 }
@@ -0,0 +1,306 @@
+package pinterest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+	Typed rows for the ads analytics
+*/
+
+// Analytics columns only returned in the response.
+const (
+	ColumnDate AnalyticsColumn = "DATE"
+)
+
+// AnalyticsRow represents a row of the ads analytics, which is for an entity, and a date if the granularity is not TOTAL.
+// The known columns are decoded into the fields, and all the columns are kept in Columns for the others.
+// A count column with a fractional value, like 12.5, is left nil and can be read with Number.
+type AnalyticsRow struct {
+	Date                            *Timestamp
+	AdAccountID                     *string
+	AdvertiserID                    *string
+	CampaignID                      *string
+	CampaignName                    *string
+	AdGroupID                       *string
+	AdID                            *string
+	PinID                           *string
+	PinPromotionID                  *string
+	ProductGroupID                  *string
+	SpendInMicroDollar              *int64
+	SpendInDollar                   *float64
+	PaidImpression                  *int64
+	TotalImpression                 *int64
+	Impression1                     *int64
+	Impression2                     *int64
+	TotalClickthrough               *int64
+	Clickthrough1                   *int64
+	Clickthrough2                   *int64
+	OutboundClick1                  *int64
+	OutboundClick2                  *int64
+	TotalEngagement                 *int64
+	Engagement1                     *int64
+	Engagement2                     *int64
+	CTR                             *float64
+	ECTR                            *float64
+	CPCInMicroDollar                *float64
+	ECPCInMicroDollar               *float64
+	CPMInMicroDollar                *float64
+	ECPMInMicroDollar               *float64
+	TotalConversions                *float64
+	TotalCheckout                   *float64
+	TotalCheckoutValueInMicroDollar *float64
+	TotalVideo3SecViews             *int64
+	TotalVideoMRCViews              *int64
+	TotalVideoP100Complete          *int64
+	TotalVideoAvgWatchtimeInSecond  *float64
+	Columns                         map[string]json.RawMessage
+}
+
+func (a AnalyticsRow) String() string {
+	// The raw columns are left out, they are the same as the fields.
+	a.Columns = nil
+	return Stringify(a)
+}
+
+func (a *AnalyticsRow) UnmarshalJSON(data []byte) error {
+	columns := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &columns); err != nil {
+		return err
+	}
+	row, err := newAnalyticsRow(columns)
+	if err != nil {
+		return err
+	}
+	*a = *row
+	return nil
+}
+
+// newAnalyticsRow Return the row with the known columns decoded.
+func newAnalyticsRow(columns map[string]json.RawMessage) (*AnalyticsRow, error) {
+	row := &AnalyticsRow{Columns: columns}
+	for col, value := range columns {
+		if bytes.Equal(value, []byte("null")) {
+			continue
+		}
+		if err := row.set(AnalyticsColumn(col), value); err != nil && err != errNotInteger {
+			return nil, fmt.Errorf("pinterest: invalid analytics column %s: %w", col, err)
+		}
+	}
+	return row, nil
+}
+
+// MarshalJSON Return the row with all the columns as received.
+func (a AnalyticsRow) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Columns)
+}
+
+func (a *AnalyticsRow) set(col AnalyticsColumn, value json.RawMessage) error {
+	switch col {
+	case ColumnDate:
+		a.Date = new(Timestamp)
+		return json.Unmarshal(value, a.Date)
+	case ColumnAdAccountID:
+		return decodeID(&a.AdAccountID, value)
+	case ColumnAdvertiserID:
+		return decodeID(&a.AdvertiserID, value)
+	case ColumnCampaignID:
+		return decodeID(&a.CampaignID, value)
+	case ColumnCampaignName:
+		return json.Unmarshal(value, &a.CampaignName)
+	case ColumnAdGroupID:
+		return decodeID(&a.AdGroupID, value)
+	case ColumnAdID:
+		return decodeID(&a.AdID, value)
+	case ColumnPinID:
+		return decodeID(&a.PinID, value)
+	case ColumnPinPromotionID:
+		return decodeID(&a.PinPromotionID, value)
+	case ColumnProductGroupID:
+		return decodeID(&a.ProductGroupID, value)
+	case ColumnSpendInMicroDollar:
+		return decodeInt(&a.SpendInMicroDollar, value)
+	case ColumnSpendInDollar:
+		return decodeFloat(&a.SpendInDollar, value)
+	case ColumnPaidImpression:
+		return decodeInt(&a.PaidImpression, value)
+	case ColumnTotalImpression:
+		return decodeInt(&a.TotalImpression, value)
+	case ColumnImpression1:
+		return decodeInt(&a.Impression1, value)
+	case ColumnImpression2:
+		return decodeInt(&a.Impression2, value)
+	case ColumnTotalClickthrough:
+		return decodeInt(&a.TotalClickthrough, value)
+	case ColumnClickthrough1:
+		return decodeInt(&a.Clickthrough1, value)
+	case ColumnClickthrough2:
+		return decodeInt(&a.Clickthrough2, value)
+	case ColumnOutboundClick1:
+		return decodeInt(&a.OutboundClick1, value)
+	case ColumnOutboundClick2:
+		return decodeInt(&a.OutboundClick2, value)
+	case ColumnTotalEngagement:
+		return decodeInt(&a.TotalEngagement, value)
+	case ColumnEngagement1:
+		return decodeInt(&a.Engagement1, value)
+	case ColumnEngagement2:
+		return decodeInt(&a.Engagement2, value)
+	case ColumnCTR:
+		return decodeFloat(&a.CTR, value)
+	case ColumnECTR:
+		return decodeFloat(&a.ECTR, value)
+	case ColumnCPCInMicroDollar:
+		return decodeFloat(&a.CPCInMicroDollar, value)
+	case ColumnECPCInMicroDollar:
+		return decodeFloat(&a.ECPCInMicroDollar, value)
+	case ColumnCPMInMicroDollar:
+		return decodeFloat(&a.CPMInMicroDollar, value)
+	case ColumnECPMInMicroDollar:
+		return decodeFloat(&a.ECPMInMicroDollar, value)
+	case ColumnTotalConversions:
+		return decodeFloat(&a.TotalConversions, value)
+	case ColumnTotalCheckout:
+		return decodeFloat(&a.TotalCheckout, value)
+	case ColumnTotalCheckoutValueInMicroDollar:
+		return decodeFloat(&a.TotalCheckoutValueInMicroDollar, value)
+	case ColumnTotalVideo3SecViews:
+		return decodeInt(&a.TotalVideo3SecViews, value)
+	case ColumnTotalVideoMRCViews:
+		return decodeInt(&a.TotalVideoMRCViews, value)
+	case ColumnTotalVideoP100Complete:
+		return decodeInt(&a.TotalVideoP100Complete, value)
+	case ColumnTotalVideoAvgWatchtimeInSecond:
+		return decodeFloat(&a.TotalVideoAvgWatchtimeInSecond, value)
+	}
+	return nil
+}
+
+// decodeID Decode the id which may be a string or a number.
+func decodeID(dst **string, value json.RawMessage) error {
+	if len(value) > 0 && value[0] == '"' {
+		return json.Unmarshal(value, dst)
+	}
+	var n json.Number
+	if err := json.Unmarshal(value, &n); err != nil {
+		return err
+	}
+	s := n.String()
+	*dst = &s
+	return nil
+}
+
+// decodeFloat Decode the number which may be quoted.
+func decodeFloat(dst **float64, value json.RawMessage) error {
+	v, err := strconv.ParseFloat(string(bytes.Trim(value, `"`)), 64)
+	if err != nil {
+		return err
+	}
+	*dst = &v
+	return nil
+}
+
+// errNotInteger is returned by decodeInt for the fractional numbers, the value is only kept in the raw columns.
+var errNotInteger = errors.New("not an integer")
+
+// decodeInt Decode the integer which may be quoted or in float format like 216.0.
+func decodeInt(dst **int64, value json.RawMessage) error {
+	s := string(bytes.Trim(value, `"`))
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		f, ferr := strconv.ParseFloat(s, 64)
+		if ferr != nil {
+			return err
+		}
+		if f != math.Trunc(f) || f >= math.MaxInt64 || f < math.MinInt64 {
+			return errNotInteger
+		}
+		v = int64(f)
+	}
+	*dst = &v
+	return nil
+}
+
+// Column Return the raw value of the column, ok is false if the column is not in the row.
+func (a *AnalyticsRow) Column(col AnalyticsColumn) (json.RawMessage, bool) {
+	v, ok := a.Columns[string(col)]
+	return v, ok
+}
+
+// Number Return the value of the numeric column, ok is false if the column is not in the row or not a number.
+func (a *AnalyticsRow) Number(col AnalyticsColumn) (float64, bool) {
+	v, ok := a.Column(col)
+	if !ok {
+		return 0, false
+	}
+	var f *float64
+	if err := decodeFloat(&f, v); err != nil {
+		return 0, false
+	}
+	return *f, true
+}
+
+// Text Return the value of the column as a string, numbers are formatted as they are received.
+func (a *AnalyticsRow) Text(col AnalyticsColumn) (string, bool) {
+	v, ok := a.Column(col)
+	if !ok || bytes.Equal(v, []byte("null")) {
+		return "", false
+	}
+	var s *string
+	if err := decodeID(&s, v); err != nil {
+		return "", false
+	}
+	return *s, true
+}
+
+// AnalyticsResponse represents the analytics response.
+type AnalyticsResponse []*AnalyticsRow
+
+// ByEntity Group the rows by the value of the id column, like ColumnCampaignID. The rows without the column are skipped.
+func (a AnalyticsResponse) ByEntity(idColumn AnalyticsColumn) map[string]AnalyticsResponse {
+	groups := make(map[string]AnalyticsResponse)
+	for _, row := range a {
+		id, ok := row.Text(idColumn)
+		if !ok {
+			continue
+		}
+		groups[id] = append(groups[id], row)
+	}
+	return groups
+}
+
+// ByDate Group the rows by the date in DateLayout. The rows without the date, e.g. for TOTAL granularity, are skipped.
+func (a AnalyticsResponse) ByDate() map[string]AnalyticsResponse {
+	groups := make(map[string]AnalyticsResponse)
+	for _, row := range a {
+		if row.Date == nil {
+			continue
+		}
+		date := row.Date.UTC().Format(DateLayout)
+		groups[date] = append(groups[date], row)
+	}
+	return groups
+}
+
+// Pivot Return the rows indexed by the entity id and then the date in DateLayout.
+// For rows with the same entity and date, the last one wins.
+func (a AnalyticsResponse) Pivot(idColumn AnalyticsColumn) map[string]map[string]*AnalyticsRow {
+	table := make(map[string]map[string]*AnalyticsRow)
+	for id, rows := range a.ByEntity(idColumn) {
+		dates := make(map[string]*AnalyticsRow, len(rows))
+		for _, row := range rows {
+			date := ""
+			if row.Date != nil {
+				date = row.Date.UTC().Format(DateLayout)
+			}
+			dates[date] = row
+		}
+		table[id] = dates
+	}
+	return table
+}
@@ -0,0 +1,288 @@
+package pinterest
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+	Split the long date ranges of the analytics into the chunks the API accepts
+*/
+
+const (
+	// AnalyticsMaxDays the max days of the date range in an analytics request.
+	AnalyticsMaxDays = 90
+	// AdAnalyticsMaxHistoryDays the max days back from today of the start date for the ads analytics.
+	AdAnalyticsMaxHistoryDays = 914
+	// UserAccountAnalyticsMaxHistoryDays the max days back from today of the start date for the user account analytics.
+	UserAccountAnalyticsMaxHistoryDays = 90
+)
+
+// DateRange represents the date range for the analytics, both the start and end dates are included.
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// StartDate Return the start date in DateLayout.
+func (d DateRange) StartDate() string {
+	return d.Start.Format(DateLayout)
+}
+
+// EndDate Return the end date in DateLayout.
+func (d DateRange) EndDate() string {
+	return d.End.Format(DateLayout)
+}
+
+// AnalyticsRangeOpts represents the options for get the analytics for a long date range.
+type AnalyticsRangeOpts struct {
+	// MaxDays the max days for each request, default to AnalyticsMaxDays.
+	MaxDays int
+	// MaxHistoryDays the max days back from today of the start date, default to the limit of the endpoint.
+	// Negative value to disable the check.
+	MaxHistoryDays int
+	// Concurrency the max requests in flight, default to 1.
+	Concurrency int
+	// Now the current time to check the history limit, default to time.Now().
+	Now time.Time
+}
+
+// truncateDate Return the midnight of the date in its location.
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// SplitDateRange Split the date range into the chunks no longer than the max days.
+// The dates are compared in the location of the start.
+func SplitDateRange(start, end time.Time, opts AnalyticsRangeOpts) ([]DateRange, error) {
+	return splitDateRange(start, end, opts, AdAnalyticsMaxHistoryDays)
+}
+
+func splitDateRange(start, end time.Time, opts AnalyticsRangeOpts, maxHistoryDays int) ([]DateRange, error) {
+	if opts.MaxDays <= 0 {
+		opts.MaxDays = AnalyticsMaxDays
+	}
+	if opts.MaxHistoryDays == 0 {
+		opts.MaxHistoryDays = maxHistoryDays
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	start = truncateDate(start)
+	end = truncateDate(end.In(start.Location()))
+	if end.Before(start) {
+		return nil, &ValidationError{Field: "end_date", Message: "before the start date"}
+	}
+	if opts.MaxHistoryDays > 0 {
+		earliest := truncateDate(opts.Now.In(start.Location())).AddDate(0, 0, -opts.MaxHistoryDays)
+		if start.Before(earliest) {
+			return nil, &ValidationError{Field: "start_date", Message: "before " + earliest.Format(DateLayout)}
+		}
+	}
+
+	var chunks []DateRange
+	for !start.After(end) {
+		chunkEnd := start.AddDate(0, 0, opts.MaxDays-1)
+		if chunkEnd.After(end) {
+			chunkEnd = end
+		}
+		chunks = append(chunks, DateRange{Start: start, End: chunkEnd})
+		start = chunkEnd.AddDate(0, 0, 1)
+	}
+	return chunks, nil
+}
+
+// fetchRanges Call the fetch for each chunk with at most concurrency calls in flight, the results are in the order
+// of the chunks. The first error cancels the other calls.
+func fetchRanges[T any](ctx context.Context, chunks []DateRange, concurrency int, fetch func(ctx context.Context, chunk DateRange) (T, error)) ([]T, error) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]T, len(chunks))
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	for i := range chunks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result, err := fetch(ctx, chunks[i])
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[i] = result
+		}(i)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// analyticsIDColumns the columns identify the entity of the analytics row.
+var analyticsIDColumns = []AnalyticsColumn{
+	ColumnAdAccountID, ColumnAdvertiserID, ColumnCampaignID, ColumnAdGroupID, ColumnAdID, ColumnPinID,
+	ColumnPinPromotionID, ColumnProductGroupID,
+}
+
+// analyticsRowKey Return the key of the row by the entity ids and the date.
+func analyticsRowKey(row *AnalyticsRow) string {
+	var b strings.Builder
+	if row.Date != nil {
+		b.WriteString(row.Date.UTC().Format(TimestampLayout))
+	}
+	for _, col := range analyticsIDColumns {
+		b.WriteByte('|')
+		if id, ok := row.Text(col); ok {
+			b.WriteString(id)
+		}
+	}
+	return b.String()
+}
+
+// MergeAnalytics Merge the analytics rows in date order. The rows for the same entity and date are
+// deduplicated, the first one wins.
+func MergeAnalytics(responses ...AnalyticsResponse) AnalyticsResponse {
+	seen := make(map[string]bool)
+	var merged AnalyticsResponse
+	for _, rows := range responses {
+		for _, row := range rows {
+			key := analyticsRowKey(row)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, row)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].Date == nil || merged[j].Date == nil {
+			return merged[i].Date == nil && merged[j].Date != nil
+		}
+		return merged[i].Date.Before(merged[j].Date.Time)
+	})
+	return merged
+}
+
+// validateRangeGranularity Check the TOTAL granularity is not split, the totals can not be merged.
+func validateRangeGranularity(granularity Granularity, chunks []DateRange) error {
+	if granularity == GranularityTotal && len(chunks) > 1 {
+		return &ValidationError{Field: "granularity", Message: "TOTAL can not be split into multiple date ranges"}
+	}
+	return nil
+}
+
+// GetAdAccountAnalyticsRange Get analytics for the ad account of the date range, the range is split into
+// the chunks the API accepts. The StartDate and EndDate of the args are ignored.
+func (r *AdAccountResource) GetAdAccountAnalyticsRange(ctx context.Context, adAccountID string, start, end time.Time, args GetAdAccountAnalyticsOpts, opts AnalyticsRangeOpts) (AnalyticsResponse, error) {
+	chunks, err := splitDateRange(start, end, opts, AdAnalyticsMaxHistoryDays)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateRangeGranularity(args.Granularity, chunks); err != nil {
+		return nil, err
+	}
+	results, err := fetchRanges(ctx, chunks, opts.Concurrency, func(ctx context.Context, chunk DateRange) (AnalyticsResponse, error) {
+		chunkArgs := args
+		chunkArgs.StartDate, chunkArgs.EndDate = chunk.StartDate(), chunk.EndDate()
+		return r.GetAdAccountAnalyticsWithContext(ctx, adAccountID, chunkArgs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MergeAnalytics(results...), nil
+}
+
+// GetCampaignAnalyticsRange Get analytics for the campaigns of the date range, the range is split into
+// the chunks the API accepts. The StartDate and EndDate of the args are ignored.
+func (r *AdAccountResource) GetCampaignAnalyticsRange(ctx context.Context, adAccountID string, start, end time.Time, args GetCampaignAnalyticsOpts, opts AnalyticsRangeOpts) (AnalyticsResponse, error) {
+	chunks, err := splitDateRange(start, end, opts, AdAnalyticsMaxHistoryDays)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateRangeGranularity(args.Granularity, chunks); err != nil {
+		return nil, err
+	}
+	results, err := fetchRanges(ctx, chunks, opts.Concurrency, func(ctx context.Context, chunk DateRange) (AnalyticsResponse, error) {
+		chunkArgs := args
+		chunkArgs.StartDate, chunkArgs.EndDate = chunk.StartDate(), chunk.EndDate()
+		return r.GetCampaignAnalyticsWithContext(ctx, adAccountID, chunkArgs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MergeAnalytics(results...), nil
+}
+
+// GetUserAccountAnalyticsRange Get analytics for the user account of the date range, the range is split into
+// the chunks the API accepts. The StartDate and EndDate of the args are ignored.
+// The daily metrics are merged in date order, the summary metrics can not be merged so it is only kept
+// when the range is fetched in one request.
+func (r *UserAccountResource) GetUserAccountAnalyticsRange(ctx context.Context, start, end time.Time, args UserAccountAnalyticsOpts, opts AnalyticsRangeOpts) (*UserAccountAnalytics, error) {
+	chunks, err := splitDateRange(start, end, opts, UserAccountAnalyticsMaxHistoryDays)
+	if err != nil {
+		return nil, err
+	}
+	results, err := fetchRanges(ctx, chunks, opts.Concurrency, func(ctx context.Context, chunk DateRange) (*UserAccountAnalytics, error) {
+		chunkArgs := args
+		chunkArgs.StartDate, chunkArgs.EndDate = chunk.StartDate(), chunk.EndDate()
+		return r.GetUserAccountAnalyticsWithContext(ctx, chunkArgs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 1 {
+		return results[0], nil
+	}
+
+	seen := make(map[string]bool)
+	merged := &UserAccountAnalyticsMetrics{}
+	for _, result := range results {
+		if result.All == nil {
+			continue
+		}
+		for _, daily := range result.All.DailyMetrics {
+			if daily.Date != nil {
+				if seen[*daily.Date] {
+					continue
+				}
+				seen[*daily.Date] = true
+			}
+			merged.DailyMetrics = append(merged.DailyMetrics, daily)
+		}
+	}
+	sort.SliceStable(merged.DailyMetrics, func(i, j int) bool {
+		di, dj := merged.DailyMetrics[i].Date, merged.DailyMetrics[j].Date
+		return di != nil && dj != nil && *di < *dj
+	})
+	return &UserAccountAnalytics{All: merged}, nil
+}
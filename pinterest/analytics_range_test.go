@@ -0,0 +1,122 @@
+package pinterest
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSplitDateRange(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2022, 1, 1, 8, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 5, 31, 23, 0, 0, 0, time.UTC)
+
+	chunks, err := SplitDateRange(start, end, AnalyticsRangeOpts{Now: now})
+	assert.Nil(t, err)
+	assert.Len(t, chunks, 2)
+	assert.Equal(t, "2022-01-01", chunks[0].StartDate())
+	assert.Equal(t, "2022-03-31", chunks[0].EndDate())
+	assert.Equal(t, "2022-04-01", chunks[1].StartDate())
+	assert.Equal(t, "2022-05-31", chunks[1].EndDate())
+
+	chunks, err = SplitDateRange(start, start, AnalyticsRangeOpts{Now: now})
+	assert.Nil(t, err)
+	assert.Len(t, chunks, 1)
+	assert.Equal(t, chunks[0].StartDate(), chunks[0].EndDate())
+
+	chunks, err = SplitDateRange(start, end, AnalyticsRangeOpts{MaxDays: 30, Now: now})
+	assert.Nil(t, err)
+	assert.Len(t, chunks, 6)
+
+	_, err = SplitDateRange(end, start, AnalyticsRangeOpts{Now: now})
+	assert.Equal(t, &ValidationError{Field: "end_date", Message: "before the start date"}, err)
+
+	_, err = splitDateRange(start, end, AnalyticsRangeOpts{Now: now}, UserAccountAnalyticsMaxHistoryDays)
+	assert.Equal(t, &ValidationError{Field: "start_date", Message: "before 2022-03-03"}, err)
+
+	_, err = splitDateRange(start, end, AnalyticsRangeOpts{Now: now, MaxHistoryDays: -1}, UserAccountAnalyticsMaxHistoryDays)
+	assert.Nil(t, err)
+}
+
+func TestMergeAnalytics(t *testing.T) {
+	var first, second AnalyticsResponse
+	_ = json.Unmarshal([]byte(`[{"CAMPAIGN_ID":"1","DATE":"2022-02-02","TOTAL_IMPRESSION":2},{"CAMPAIGN_ID":"1","DATE":"2022-02-01","TOTAL_IMPRESSION":1}]`), &first)
+	_ = json.Unmarshal([]byte(`[{"CAMPAIGN_ID":"1","DATE":"2022-02-02","TOTAL_IMPRESSION":3},{"CAMPAIGN_ID":"2","DATE":"2022-02-02","TOTAL_IMPRESSION":4},{"CAMPAIGN_ID":"1","DATE":"2022-02-03","TOTAL_IMPRESSION":5}]`), &second)
+
+	merged := MergeAnalytics(first, second)
+	assert.Len(t, merged, 4)
+	assert.Equal(t, "2022-02-01", merged[0].Date.Format(DateLayout))
+	assert.Equal(t, int64(2), *merged[1].TotalImpression)
+	assert.Equal(t, "2", *merged[2].CampaignID)
+	assert.Equal(t, "2022-02-03", merged[3].Date.Format(DateLayout))
+}
+
+func (bc *BCSuite) TestGetAdAccountAnalyticsRange() {
+	var (
+		mu     sync.Mutex
+		ranges []string
+	)
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/549755885175/analytics",
+		func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			mu.Lock()
+			ranges = append(ranges, q.Get("start_date")+"/"+q.Get("end_date"))
+			mu.Unlock()
+			if q.Get("start_date") == "2022-02-03" {
+				// the API may return the extra day
+				return httpmock.NewStringResponse(200, `[{"AD_ACCOUNT_ID":"549755885175","DATE":"2022-02-02","TOTAL_IMPRESSION":0},{"AD_ACCOUNT_ID":"549755885175","DATE":"2022-02-03","TOTAL_IMPRESSION":3}]`), nil
+			}
+			return httpmock.NewStringResponse(200, `[{"AD_ACCOUNT_ID":"549755885175","DATE":"`+q.Get("end_date")+`","TOTAL_IMPRESSION":2},{"AD_ACCOUNT_ID":"549755885175","DATE":"`+q.Get("start_date")+`","TOTAL_IMPRESSION":1}]`), nil
+		},
+	)
+
+	start := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 2, 3, 0, 0, 0, 0, time.UTC)
+	opts := AnalyticsRangeOpts{MaxDays: 2, Concurrency: 2, Now: end}
+	rows, err := bc.Pin.AdAccount.GetAdAccountAnalyticsRange(context.Background(), "549755885175", start, end, GetAdAccountAnalyticsOpts{Granularity: GranularityDay}, opts)
+	bc.Nil(err)
+	bc.ElementsMatch([]string{"2022-02-01/2022-02-02", "2022-02-03/2022-02-03"}, ranges)
+	bc.Len(rows, 3)
+	bc.Equal("2022-02-01", rows[0].Date.Format(DateLayout))
+	bc.Equal(int64(2), *rows[1].TotalImpression)
+	bc.Equal(int64(3), *rows[2].TotalImpression)
+
+	_, err = bc.Pin.AdAccount.GetAdAccountAnalyticsRange(context.Background(), "549755885175", start, end, GetAdAccountAnalyticsOpts{Granularity: GranularityTotal}, opts)
+	bc.IsType(&ValidationError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/549755885175/campaigns/analytics",
+		httpmock.NewStringResponder(400, `{"code":1,"message":"Invalid parameters."}`),
+	)
+	_, err = bc.Pin.AdAccount.GetCampaignAnalyticsRange(context.Background(), "549755885175", start, end, GetCampaignAnalyticsOpts{CampaignIDs: []string{"626736533506"}}, opts)
+	bc.IsType(&APIError{}, err)
+}
+
+func (bc *BCSuite) TestGetUserAccountAnalyticsRange() {
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/user_account/analytics",
+		func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			return httpmock.NewStringResponse(200, `{"all":{"daily_metrics":[{"date":"`+q.Get("end_date")+`","metrics":{"IMPRESSION":2}},{"date":"`+q.Get("start_date")+`","metrics":{"IMPRESSION":1}}],"summary_metrics":{"IMPRESSION":3}}}`), nil
+		},
+	)
+
+	start := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 2, 4, 0, 0, 0, 0, time.UTC)
+	analytics, err := bc.Pin.UserAccount.GetUserAccountAnalyticsRange(context.Background(), start, end, UserAccountAnalyticsOpts{}, AnalyticsRangeOpts{MaxDays: 2, Now: end})
+	bc.Nil(err)
+	bc.Nil(analytics.All.SummaryMetrics)
+	bc.Len(analytics.All.DailyMetrics, 4)
+	bc.Equal("2022-02-01", *analytics.All.DailyMetrics[0].Date)
+	bc.Equal("2022-02-04", *analytics.All.DailyMetrics[3].Date)
+
+	analytics, err = bc.Pin.UserAccount.GetUserAccountAnalyticsRange(context.Background(), start, start, UserAccountAnalyticsOpts{}, AnalyticsRangeOpts{Now: end})
+	bc.Nil(err)
+	bc.Equal(int64(3), *analytics.All.SummaryMetrics.Impression)
+}
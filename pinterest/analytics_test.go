@@ -0,0 +1,89 @@
+package pinterest
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestAnalyticsRow(t *testing.T) {
+	var rows AnalyticsResponse
+	err := json.Unmarshal([]byte(`[
+		{"DATE":"2021-04-01","CAMPAIGN_ID":547602124502,"AD_ACCOUNT_ID":"549755885175","SPEND_IN_MICRO_DOLLAR":30000000,"IMPRESSION_1":"1200","CLICKTHROUGH_1":216.0,"CTR":0.18,"ECTR":null,"TOTAL_WEB_SESSIONS":12},
+		{"DATE":"2021-04-02","CAMPAIGN_ID":547602124502,"SPEND_IN_MICRO_DOLLAR":10000000,"TOTAL_IMPRESSION":12.5},
+		{"DATE":"2021-04-01","CAMPAIGN_ID":"547602124503","SPEND_IN_MICRO_DOLLAR":0},
+		{"SPEND_IN_MICRO_DOLLAR":40000000}
+	]`), &rows)
+	assert.Nil(t, err)
+
+	row := rows[0]
+	assert.Equal(t, "2021-04-01", row.Date.Format(DateLayout))
+	assert.Equal(t, "547602124502", *row.CampaignID)
+	assert.Equal(t, "549755885175", *row.AdAccountID)
+	assert.Equal(t, int64(30000000), *row.SpendInMicroDollar)
+	assert.Equal(t, int64(1200), *row.Impression1)
+	assert.Equal(t, int64(216), *row.Clickthrough1)
+	assert.Equal(t, 0.18, *row.CTR)
+	assert.Nil(t, row.ECTR)
+
+	// the fractional count is only kept in the raw columns
+	assert.Nil(t, rows[1].TotalImpression)
+	impression, ok := rows[1].Number(ColumnTotalImpression)
+	assert.True(t, ok)
+	assert.Equal(t, 12.5, impression)
+
+	// unknown columns
+	sessions, ok := row.Number(ColumnTotalWebSessions)
+	assert.True(t, ok)
+	assert.Equal(t, float64(12), sessions)
+	_, ok = row.Number(ColumnTotalLead)
+	assert.False(t, ok)
+	id, ok := row.Text(ColumnCampaignID)
+	assert.True(t, ok)
+	assert.Equal(t, "547602124502", id)
+
+	data, err := json.Marshal(rows[3])
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"SPEND_IN_MICRO_DOLLAR":40000000}`, string(data))
+	assert.Equal(t, `pinterest.AnalyticsRow{SpendInMicroDollar:40000000}`, rows[3].String())
+
+	err = json.Unmarshal([]byte(`[{"SPEND_IN_MICRO_DOLLAR":"a lot"}]`), &rows)
+	assert.NotNil(t, err)
+}
+
+func TestAnalyticsPivot(t *testing.T) {
+	var rows AnalyticsResponse
+	_ = json.Unmarshal([]byte(`[
+		{"DATE":"2021-04-01","CAMPAIGN_ID":"1","SPEND_IN_MICRO_DOLLAR":1},
+		{"DATE":"2021-04-02","CAMPAIGN_ID":"1","SPEND_IN_MICRO_DOLLAR":2},
+		{"DATE":"2021-04-01","CAMPAIGN_ID":"2","SPEND_IN_MICRO_DOLLAR":3},
+		{"SPEND_IN_MICRO_DOLLAR":4}
+	]`), &rows)
+
+	byEntity := rows.ByEntity(ColumnCampaignID)
+	assert.Len(t, byEntity, 2)
+	assert.Len(t, byEntity["1"], 2)
+
+	byDate := rows.ByDate()
+	assert.Len(t, byDate, 2)
+	assert.Len(t, byDate["2021-04-01"], 2)
+
+	table := rows.Pivot(ColumnCampaignID)
+	assert.Equal(t, int64(2), *table["1"]["2021-04-02"].SpendInMicroDollar)
+	assert.Equal(t, int64(3), *table["2"]["2021-04-01"].SpendInMicroDollar)
+}
+
+func TestDecodeInt(t *testing.T) {
+	var v *int64
+	assert.Nil(t, decodeInt(&v, json.RawMessage(`"216.0"`)))
+	assert.Equal(t, int64(216), *v)
+	assert.Nil(t, decodeInt(&v, json.RawMessage(`-9223372036854775808.0`)))
+	assert.Equal(t, int64(math.MinInt64), *v)
+
+	v = nil
+	assert.Equal(t, errNotInteger, decodeInt(&v, json.RawMessage(`12.5`)))
+	// 2^63 is out of the range of int64
+	assert.Equal(t, errNotInteger, decodeInt(&v, json.RawMessage(`9223372036854775808.0`)))
+	assert.Nil(t, v)
+}
@@ -2,8 +2,15 @@ package pinterest
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"golang.org/x/oauth2"
 	"net/http"
+	"strings"
 )
 
 // Endpoint url for pinterest oauth2
@@ -13,14 +20,40 @@ var Endpoint = oauth2.Endpoint{
 	AuthStyle: oauth2.AuthStyleInHeader,
 }
 
+// Scope represents the oauth2 scope for the app.
+// Refer: https://developers.pinterest.com/docs/getting-started/scopes/
+type Scope string
+
+const (
+	ScopeAdsRead           Scope = "ads:read"
+	ScopeAdsWrite          Scope = "ads:write"
+	ScopeBoardsRead        Scope = "boards:read"
+	ScopeBoardsReadSecret  Scope = "boards:read_secret"
+	ScopeBoardsWrite       Scope = "boards:write"
+	ScopeBoardsWriteSecret Scope = "boards:write_secret"
+	ScopeCatalogsRead      Scope = "catalogs:read"
+	ScopeCatalogsWrite     Scope = "catalogs:write"
+	ScopePinsRead          Scope = "pins:read"
+	ScopePinsReadSecret    Scope = "pins:read_secret"
+	ScopePinsWrite         Scope = "pins:write"
+	ScopePinsWriteSecret   Scope = "pins:write_secret"
+	ScopeUserAccountsRead  Scope = "user_accounts:read"
+	ScopeUserAccountsWrite Scope = "user_accounts:write"
+)
+
+// ErrInvalidState is returned when the state of the authorization callback not matches the session.
+var ErrInvalidState = errors.New("pinterest: invalid oauth2 state")
+
 // AuthorizationAPP Pinterest OAuth2 app config
 type AuthorizationAPP struct {
-	ClientID     string         `json:"client_id"`
-	ClientSecret string         `json:"client_secret"`
-	RedirectURI  string         `json:"redirect_uri,omitempty"`
-	Scope        string         `json:"scope,omitempty"`
-	Token        *oauth2.Token  `json:"access_token,omitempty"`
-	Config       *oauth2.Config `json:"config,omitempty"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RedirectURI  string `json:"redirect_uri,omitempty"`
+	// Scope comma separated scopes, prefer to use Scopes.
+	Scope  string         `json:"scope,omitempty"`
+	Scopes []Scope        `json:"scopes,omitempty"`
+	Token  *oauth2.Token  `json:"access_token,omitempty"`
+	Config *oauth2.Config `json:"config,omitempty"`
 }
 
 func (app AuthorizationAPP) String() string {
@@ -33,20 +66,192 @@ func NewAuthorizationAPP(app AuthorizationAPP) *AuthorizationAPP {
 		ClientID:     app.ClientID,
 		ClientSecret: app.ClientSecret,
 		RedirectURL:  app.RedirectURI,
-		Scopes:       []string{app.Scope},
+		Scopes:       joinScopes(app.Scope, app.Scopes),
 		Endpoint:     Endpoint,
 	}
 	return &app
 }
 
+// joinScopes Return the scopes for the oauth2 config. Pinterest requires the scopes separated by comma,
+// so they are joined into one.
+func joinScopes(scope string, scopes []Scope) []string {
+	var all []string
+	seen := make(map[string]bool)
+	add := func(s string) {
+		s = strings.TrimSpace(s)
+		if s != "" && !seen[s] {
+			seen[s] = true
+			all = append(all, s)
+		}
+	}
+	for _, s := range strings.Split(scope, ",") {
+		add(s)
+	}
+	for _, s := range scopes {
+		add(string(s))
+	}
+	if len(all) == 0 {
+		return nil
+	}
+	return []string{strings.Join(all, ",")}
+}
+
 // GetAuthorizationURL Return authorization url for user
+//
+// Deprecated: the url always uses the constant OAuthState, which can not protect the callback from CSRF.
+// Use NewAuthorizationSession instead.
 func (app *AuthorizationAPP) GetAuthorizationURL() string {
 	return app.Config.AuthCodeURL(OAuthState)
 }
 
+// AuthorizationSessionOpts represents the parameters for new authorization session.
+type AuthorizationSessionOpts struct {
+	// PKCE whether to use the Proof Key for Code Exchange with S256 method.
+	PKCE bool
+}
+
+// AuthorizationSession represents a single authorization for the user, it should be kept
+// (e.g. in the user's session) until the callback to verify the state and exchange the code.
+type AuthorizationSession struct {
+	State        string `json:"state"`
+	CodeVerifier string `json:"code_verifier,omitempty"`
+	URL          string `json:"url"`
+}
+
+func (s AuthorizationSession) String() string {
+	return Stringify(s)
+}
+
+// VerifyState Check the state from the callback matches the session.
+func (s *AuthorizationSession) VerifyState(state string) error {
+	if s.State == "" || subtle.ConstantTimeCompare([]byte(s.State), []byte(state)) != 1 {
+		return ErrInvalidState
+	}
+	return nil
+}
+
+// randomString Return a url safe random string from n random bytes.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// codeChallenge Return the S256 PKCE code challenge for the verifier.
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// NewAuthorizationSession Return a session with a random state, and the authorization url for the user.
+func (app *AuthorizationAPP) NewAuthorizationSession(opts AuthorizationSessionOpts) (*AuthorizationSession, error) {
+	state, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+	session := &AuthorizationSession{State: state}
+
+	var params []oauth2.AuthCodeOption
+	if opts.PKCE {
+		session.CodeVerifier, err = randomString(32)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params,
+			oauth2.SetAuthURLParam("code_challenge", codeChallenge(session.CodeVerifier)),
+			oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+		)
+	}
+	session.URL = app.Config.AuthCodeURL(state, params...)
+	return session, nil
+}
+
+// AuthorizationCallbackError represents the error returned to the redirect uri, e.g. the user denied the access.
+type AuthorizationCallbackError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *AuthorizationCallbackError) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("Pinterest OAuth Error: %s", e.Code)
+	}
+	return fmt.Sprintf("Pinterest OAuth Error: %s, %s", e.Code, e.Description)
+}
+
+// AuthorizationCallback represents the parameters of the redirect request.
+type AuthorizationCallback struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+func (c AuthorizationCallback) String() string {
+	return Stringify(c)
+}
+
+// ParseAuthorizationCallback Parse the code and state from the redirect request.
+// An *AuthorizationCallbackError is returned if the redirect carries an error.
+func ParseAuthorizationCallback(r *http.Request) (*AuthorizationCallback, error) {
+	query := r.URL.Query()
+	if code := query.Get("error"); code != "" {
+		return nil, &AuthorizationCallbackError{Code: code, Description: query.Get("error_description")}
+	}
+	callback := &AuthorizationCallback{Code: query.Get("code"), State: query.Get("state")}
+	if callback.Code == "" {
+		return nil, &AuthorizationCallbackError{Code: "invalid_request", Description: "missing code"}
+	}
+	return callback, nil
+}
+
+// CompleteAuthorization Verify the state of the redirect request with the session, and exchange the code for the token.
+// The token is only returned, not set to the app, as the app may serve many users. Save it where it belongs,
+// e.g. in a TokenStore.
+func (app *AuthorizationAPP) CompleteAuthorization(ctx context.Context, session *AuthorizationSession, r *http.Request) (*oauth2.Token, error) {
+	callback, err := ParseAuthorizationCallback(r)
+	if err != nil {
+		return nil, err
+	}
+	if err = session.VerifyState(callback.State); err != nil {
+		return nil, err
+	}
+
+	var params []oauth2.AuthCodeOption
+	if session.CodeVerifier != "" {
+		params = append(params, oauth2.SetAuthURLParam("code_verifier", session.CodeVerifier))
+	}
+	token, err := app.Config.Exchange(ctx, callback.Code, params...)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// CallbackHandler Return a handler for the redirect uri. It loads the session for the request by sessionFor
+// (e.g. from the cookie), completes the authorization, and calls done with the token or the error.
+func (app *AuthorizationAPP) CallbackHandler(
+	sessionFor func(r *http.Request) (*AuthorizationSession, error),
+	done func(w http.ResponseWriter, r *http.Request, token *oauth2.Token, err error),
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session, err := sessionFor(r)
+		if err != nil {
+			done(w, r, nil, err)
+			return
+		}
+		token, err := app.CompleteAuthorization(r.Context(), session, r)
+		done(w, r, token, err)
+	})
+}
+
 // GenerateAccessToken Generate user access token for the app
 func (app *AuthorizationAPP) GenerateAccessToken(code string) (*oauth2.Token, error) {
-	ctx := context.Background()
+	return app.GenerateAccessTokenWithContext(context.Background(), code)
+}
+
+// GenerateAccessTokenWithContext is the same as GenerateAccessToken, but with a context for the token exchange.
+func (app *AuthorizationAPP) GenerateAccessTokenWithContext(ctx context.Context, code string) (*oauth2.Token, error) {
 	token, err := app.Config.Exchange(ctx, code)
 	if err != nil {
 		return nil, err
@@ -57,7 +262,13 @@ func (app *AuthorizationAPP) GenerateAccessToken(code string) (*oauth2.Token, er
 
 // GetAuthorizedHttpClient Get user authorized http client
 func (app *AuthorizationAPP) GetAuthorizedHttpClient() *http.Client {
-	hc := app.Config.Client(context.TODO(), app.Token)
+	return app.GetAuthorizedHttpClientWithContext(context.Background())
+}
+
+// GetAuthorizedHttpClientWithContext is the same as GetAuthorizedHttpClient, but ctx is used
+// when refreshing the token. The ctx should live as long as the returned client.
+func (app *AuthorizationAPP) GetAuthorizedHttpClientWithContext(ctx context.Context) *http.Client {
+	hc := app.Config.Client(ctx, app.Token)
 	return hc
 }
 
@@ -66,3 +277,9 @@ func (app *AuthorizationAPP) GetUserClient() *Client {
 	hc := app.GetAuthorizedHttpClient()
 	return NewUserClint(hc)
 }
+
+// GetUserClientWithContext is the same as GetUserClient, but ctx is used when refreshing the token.
+func (app *AuthorizationAPP) GetUserClientWithContext(ctx context.Context) *Client {
+	hc := app.GetAuthorizedHttpClientWithContext(ctx)
+	return NewUserClint(hc)
+}
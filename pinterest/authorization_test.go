@@ -1,10 +1,14 @@
 package pinterest
 
 import (
+	"context"
+	"errors"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/oauth2"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -61,3 +65,127 @@ func (auth *Auth2Suite) TestGenerateAccessToken() {
 	cli := auth.app.GetUserClient()
 	auth.IsType(&Client{}, cli)
 }
+
+func (auth *Auth2Suite) TestGenerateAccessTokenWithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := auth.app.GenerateAccessTokenWithContext(ctx, "code")
+	auth.NotNil(err)
+
+	cli := auth.app.GetUserClientWithContext(context.Background())
+	auth.IsType(&Client{}, cli)
+}
+
+func (auth *Auth2Suite) TestScopes() {
+	app := NewAuthorizationAPP(AuthorizationAPP{
+		ClientID: "client id",
+		Scope:    "boards:read, pins:read",
+		Scopes:   []Scope{ScopePinsRead, ScopeAdsRead},
+	})
+	auth.Equal([]string{"boards:read,pins:read,ads:read"}, app.Config.Scopes)
+
+	app = NewAuthorizationAPP(AuthorizationAPP{ClientID: "client id"})
+	auth.Nil(app.Config.Scopes)
+}
+
+func (auth *Auth2Suite) TestNewAuthorizationSession() {
+	session, err := auth.app.NewAuthorizationSession(AuthorizationSessionOpts{})
+	auth.Nil(err)
+	auth.NotEqual(OAuthState, session.State)
+	auth.Empty(session.CodeVerifier)
+	auth.Contains(session.URL, "state="+session.State)
+	auth.NotContains(session.URL, "code_challenge")
+
+	other, _ := auth.app.NewAuthorizationSession(AuthorizationSessionOpts{})
+	auth.NotEqual(session.State, other.State)
+
+	auth.Nil(session.VerifyState(session.State))
+	auth.Equal(ErrInvalidState, session.VerifyState(other.State))
+	auth.Equal(ErrInvalidState, (&AuthorizationSession{}).VerifyState(""))
+
+	session, _ = auth.app.NewAuthorizationSession(AuthorizationSessionOpts{PKCE: true})
+	auth.NotEmpty(session.CodeVerifier)
+	u, _ := url.Parse(session.URL)
+	auth.Equal("S256", u.Query().Get("code_challenge_method"))
+	auth.Equal(codeChallenge(session.CodeVerifier), u.Query().Get("code_challenge"))
+	auth.Equal("boards:read,pins:read", u.Query().Get("scope"))
+}
+
+func (auth *Auth2Suite) TestCodeChallenge() {
+	// example from RFC 7636 Appendix B
+	auth.Equal("E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", codeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
+
+func (auth *Auth2Suite) TestParseAuthorizationCallback() {
+	r := httptest.NewRequest(HttpGet, "https://localhost/?code=abc&state=xyz", nil)
+	callback, err := ParseAuthorizationCallback(r)
+	auth.Nil(err)
+	auth.Equal("abc", callback.Code)
+	auth.Equal("xyz", callback.State)
+
+	r = httptest.NewRequest(HttpGet, "https://localhost/?error=access_denied&error_description=denied+by+user", nil)
+	_, err = ParseAuthorizationCallback(r)
+	var callbackErr *AuthorizationCallbackError
+	auth.True(errors.As(err, &callbackErr))
+	auth.Equal("access_denied", callbackErr.Code)
+	auth.Contains(err.Error(), "denied by user")
+
+	r = httptest.NewRequest(HttpGet, "https://localhost/?state=xyz", nil)
+	_, err = ParseAuthorizationCallback(r)
+	auth.IsType(&AuthorizationCallbackError{}, err)
+}
+
+func (auth *Auth2Suite) TestCompleteAuthorization() {
+	var verifier string
+	httpmock.RegisterResponder(
+		HttpPost, Endpoint.TokenURL,
+		func(req *http.Request) (*http.Response, error) {
+			req.ParseForm()
+			verifier = req.PostForm.Get("code_verifier")
+			return httpmock.NewStringResponse(200, `{"access_token":"token","token_type":"bearer","expires_in":3600,"refresh_token":"refresh"}`), nil
+		},
+	)
+
+	auth.app.Token = nil
+	session, _ := auth.app.NewAuthorizationSession(AuthorizationSessionOpts{PKCE: true})
+	r := httptest.NewRequest(HttpGet, "https://localhost/?code=abc&state=bad", nil)
+	_, err := auth.app.CompleteAuthorization(context.Background(), session, r)
+	auth.Equal(ErrInvalidState, err)
+
+	r = httptest.NewRequest(HttpGet, "https://localhost/?code=abc&state="+session.State, nil)
+	token, err := auth.app.CompleteAuthorization(context.Background(), session, r)
+	auth.Nil(err)
+	auth.Equal("token", token.AccessToken)
+	auth.Equal(session.CodeVerifier, verifier)
+	// the token is not shared by the app
+	auth.Nil(auth.app.Token)
+
+	// handler
+	handler := auth.app.CallbackHandler(
+		func(r *http.Request) (*AuthorizationSession, error) { return session, nil },
+		func(w http.ResponseWriter, r *http.Request, token *oauth2.Token, err error) {
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(token.AccessToken))
+		},
+	)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(HttpGet, "https://localhost/?code=abc&state="+session.State, nil))
+	auth.Equal("token", w.Body.String())
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(HttpGet, "https://localhost/?error=access_denied", nil))
+	auth.Equal(http.StatusBadRequest, w.Code)
+
+	handler = auth.app.CallbackHandler(
+		func(r *http.Request) (*AuthorizationSession, error) { return nil, errors.New("no session") },
+		func(w http.ResponseWriter, r *http.Request, token *oauth2.Token, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+	)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(HttpGet, "https://localhost/?code=abc", nil))
+	auth.Contains(w.Body.String(), "no session")
+}
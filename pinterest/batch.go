@@ -0,0 +1,108 @@
+package pinterest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+	Responses for the bulk create and update endpoints, each item may fail on its own
+*/
+
+// BatchException represents the error for an item of the bulk request.
+type BatchException struct {
+	Code    *int    `json:"code"`
+	Message *string `json:"message"`
+}
+
+func (b BatchException) String() string {
+	return Stringify(b)
+}
+
+// BatchItem represents the result for an item of the bulk request, Data is nil if the item failed.
+type BatchItem[T any] struct {
+	Data       *T                `json:"data"`
+	Exceptions []*BatchException `json:"exceptions"`
+}
+
+// BatchResponse represents the response for the bulk request, the items are in the same order as the request.
+type BatchResponse[T any] struct {
+	Items []*BatchItem[T] `json:"items"`
+}
+
+// BatchItemError represents the error for the item at Index of the bulk request.
+type BatchItemError struct {
+	Index   int
+	Code    int
+	Message string
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("Pinterest Error, item %d: %s (code %d)", e.Index, e.Message, e.Code)
+}
+
+// BatchError represents the failed items of the bulk request.
+type BatchError struct {
+	Errors []*BatchItemError
+}
+
+func (e *BatchError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("Pinterest Error, %d items failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Err Return the error for the item at index i, nil if the item succeeded.
+func (r *BatchResponse[T]) Err(i int) error {
+	item := r.Items[i]
+	if len(item.Exceptions) == 0 {
+		return nil
+	}
+	e := &BatchItemError{Index: i}
+	if ex := item.Exceptions[0]; ex != nil {
+		if ex.Code != nil {
+			e.Code = *ex.Code
+		}
+		if ex.Message != nil {
+			e.Message = *ex.Message
+		}
+	}
+	return e
+}
+
+// Errors Return a BatchError with all the failed items, nil if all the items succeeded.
+func (r *BatchResponse[T]) Errors() error {
+	var errs []*BatchItemError
+	for i := range r.Items {
+		if err := r.Err(i); err != nil {
+			errs = append(errs, err.(*BatchItemError))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &BatchError{Errors: errs}
+}
+
+// Succeeded Return the data of the succeeded items.
+func (r *BatchResponse[T]) Succeeded() []*T {
+	var data []*T
+	for _, item := range r.Items {
+		if item.Data != nil && len(item.Exceptions) == 0 {
+			data = append(data, item.Data)
+		}
+	}
+	return data
+}
+
+// batchValidationError Return the validation error with the field prefixed by the item index.
+func batchValidationError(i int, err error) error {
+	var vErr *ValidationError
+	if errors.As(err, &vErr) {
+		return &ValidationError{Field: fmt.Sprintf("items[%d].%s", i, vErr.Field), Message: vErr.Message}
+	}
+	return err
+}
@@ -1,5 +1,7 @@
 package pinterest
 
+import "context"
+
 /*
 	Boards API
 */
@@ -19,14 +21,30 @@ func (b BoardOwner) String() string {
 	return Stringify(b)
 }
 
+// BoardMedia represents the cover images for board
+type BoardMedia struct {
+	ImageCoverURL    *string  `json:"image_cover_url"`
+	PinThumbnailURLs []string `json:"pin_thumbnail_urls"`
+}
+
+func (b BoardMedia) String() string {
+	return Stringify(b)
+}
+
 // Board represents the board info
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/get
 type Board struct {
-	ID          *string     `json:"id"`
-	Name        *string     `json:"name"`
-	Description *string     `json:"description"`
-	Owner       *BoardOwner `json:"owner"`
-	Privacy     *string     `json:"privacy"`
+	ID                  *string     `json:"id"`
+	Name                *string     `json:"name"`
+	Description         *string     `json:"description"`
+	Owner               *BoardOwner `json:"owner"`
+	Privacy             *Privacy    `json:"privacy"`
+	CreatedAt           *Timestamp  `json:"created_at"`
+	BoardPinsModifiedAt *Timestamp  `json:"board_pins_modified_at"`
+	PinCount            *int        `json:"pin_count"`
+	FollowerCount       *int        `json:"follower_count"`
+	CollaboratorCount   *int        `json:"collaborator_count"`
+	Media               *BoardMedia `json:"media"`
 }
 
 func (b Board) String() string {
@@ -46,29 +64,59 @@ func (b BoardsResponse) String() string {
 // ListBoardOpts represents the parameters for list boards
 type ListBoardOpts struct {
 	ListOptions
-	Privacy string `url:"privacy,omitempty"`
+	Privacy Privacy `url:"privacy,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (l ListBoardOpts) Validate() error {
+	return validateEnum("privacy", l.Privacy)
 }
 
 // ListBoards Get a list of the boards owned by the "operation user_account" + group boards where this account is a collaborator
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/list
-func (r *BoardResource) ListBoards(args ListBoardOpts) (*BoardsResponse, *APIError) {
+func (r *BoardResource) ListBoards(args ListBoardOpts) (*BoardsResponse, error) {
+	return r.ListBoardsWithContext(context.Background(), args)
+}
+
+// ListBoardsWithContext is the same as ListBoards, but with a context for the request.
+func (r *BoardResource) ListBoardsWithContext(ctx context.Context, args ListBoardOpts) (*BoardsResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/boards"
 
 	resp := new(BoardsResponse)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// ListBoardsIter Return an iterator walking through all the boards, the pages are fetched by the bookmark.
+func (r *BoardResource) ListBoardsIter(args ListBoardOpts) *Iterator[*Board] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*Board, *string, error) {
+		args.ListOptions = opts
+		resp, err := r.ListBoardsWithContext(ctx, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args.ListOptions)
+}
+
 // GetBoard Get a board owned by the operation user_account - or a group board that has been shared with this account.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/get
-func (r *BoardResource) GetBoard(boardID string) (*Board, *APIError) {
+func (r *BoardResource) GetBoard(boardID string) (*Board, error) {
+	return r.GetBoardWithContext(context.Background(), boardID)
+}
+
+// GetBoardWithContext is the same as GetBoard, but with a context for the request.
+func (r *BoardResource) GetBoardWithContext(ctx context.Context, boardID string) (*Board, error) {
 	path := "/boards/" + boardID
 
 	resp := new(Board)
-	err := r.Cli.DoGet(path, nil, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -77,18 +125,31 @@ func (r *BoardResource) GetBoard(boardID string) (*Board, *APIError) {
 
 // CreateBoardOpts represents the parameters for create a board
 type CreateBoardOpts struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	Privacy     string `json:"privacy,omitempty"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Privacy     Privacy `json:"privacy,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (c CreateBoardOpts) Validate() error {
+	return validateEnum("privacy", c.Privacy)
 }
 
 // CreateBoard Create a board owned by the "operation user_account".
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/create
-func (r *BoardResource) CreateBoard(args CreateBoardOpts) (*Board, *APIError) {
+func (r *BoardResource) CreateBoard(args CreateBoardOpts) (*Board, error) {
+	return r.CreateBoardWithContext(context.Background(), args)
+}
+
+// CreateBoardWithContext is the same as CreateBoard, but with a context for the request.
+func (r *BoardResource) CreateBoardWithContext(ctx context.Context, args CreateBoardOpts) (*Board, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/boards"
 
 	resp := new(Board)
-	err := r.Cli.DoPost(path, args, resp)
+	err := r.Cli.DoPostWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -97,17 +158,30 @@ func (r *BoardResource) CreateBoard(args CreateBoardOpts) (*Board, *APIError) {
 
 // UpdateBoardOpts represents the parameters for update board
 type UpdateBoardOpts struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	Privacy     string `json:"privacy,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Privacy     Privacy `json:"privacy,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (u UpdateBoardOpts) Validate() error {
+	return validateEnum("privacy", u.Privacy)
 }
 
 // UpdateBoard Update a board owned by the "operating user_account".
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/update
-func (r *BoardResource) UpdateBoard(boardID string, args UpdateBoardOpts) (*Board, *APIError) {
+func (r *BoardResource) UpdateBoard(boardID string, args UpdateBoardOpts) (*Board, error) {
+	return r.UpdateBoardWithContext(context.Background(), boardID, args)
+}
+
+// UpdateBoardWithContext is the same as UpdateBoard, but with a context for the request.
+func (r *BoardResource) UpdateBoardWithContext(ctx context.Context, boardID string, args UpdateBoardOpts) (*Board, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/boards/" + boardID
 	resp := new(Board)
-	err := r.Cli.DoPatch(path, args, resp)
+	err := r.Cli.DoPatchWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -116,10 +190,15 @@ func (r *BoardResource) UpdateBoard(boardID string, args UpdateBoardOpts) (*Boar
 
 // DeleteBoard Delete a board owned by the "operation user_account".
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/delete
-func (r *BoardResource) DeleteBoard(boardID string) *APIError {
+func (r *BoardResource) DeleteBoard(boardID string) error {
+	return r.DeleteBoardWithContext(context.Background(), boardID)
+}
+
+// DeleteBoardWithContext is the same as DeleteBoard, but with a context for the request.
+func (r *BoardResource) DeleteBoardWithContext(ctx context.Context, boardID string) error {
 	path := "/boards/" + boardID
 
-	err := r.Cli.DoDelete(path, nil)
+	err := r.Cli.DoDeleteWithContext(ctx, path, nil)
 	if err != nil {
 		return err
 	}
@@ -128,13 +207,29 @@ func (r *BoardResource) DeleteBoard(boardID string) *APIError {
 
 // ListPinsOnBoard Get a list of the Pins on a board owned by the "operation user_account" - or on a group board that has been shared with this account.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/list_pins
-func (r *BoardResource) ListPinsOnBoard(boardID string, args ListOptions) (*PinsResponse, *APIError) {
+func (r *BoardResource) ListPinsOnBoard(boardID string, args ListOptions) (*PinsResponse, error) {
+	return r.ListPinsOnBoardWithContext(context.Background(), boardID, args)
+}
+
+// ListPinsOnBoardWithContext is the same as ListPinsOnBoard, but with a context for the request.
+func (r *BoardResource) ListPinsOnBoardWithContext(ctx context.Context, boardID string, args ListOptions) (*PinsResponse, error) {
 	path := "/boards/" + boardID + "/pins"
 
 	resp := new(PinsResponse)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
+
+// ListPinsOnBoardIter Return an iterator walking through all the pins on the board, the pages are fetched by the bookmark.
+func (r *BoardResource) ListPinsOnBoardIter(boardID string, args ListOptions) *Iterator[*Pin] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*Pin, *string, error) {
+		resp, err := r.ListPinsOnBoardWithContext(ctx, boardID, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args)
+}
@@ -0,0 +1,234 @@
+package pinterest
+
+import "context"
+
+/*
+	Board collaborators and invites API
+*/
+
+// BoardCollaborator represents the collaborator of a board.
+type BoardCollaborator struct {
+	ID       *string      `json:"id"`
+	Username *string      `json:"username"`
+	Access   *BoardAccess `json:"access"`
+}
+
+func (b BoardCollaborator) String() string {
+	return Stringify(b)
+}
+
+// BoardCollaboratorsResponse represents the response for list board collaborators.
+type BoardCollaboratorsResponse struct {
+	Items    []*BoardCollaborator `json:"items"`
+	Bookmark *string              `json:"bookmark"`
+}
+
+func (b BoardCollaboratorsResponse) String() string {
+	return Stringify(b)
+}
+
+// BoardInvite represents the invite to collaborate on a board.
+type BoardInvite struct {
+	ID        *string            `json:"id"`
+	BoardID   *string            `json:"board_id"`
+	Invitee   *BoardOwner        `json:"invitee"`
+	Inviter   *BoardOwner        `json:"inviter"`
+	Email     *string            `json:"email"`
+	Access    *BoardAccess       `json:"access"`
+	Status    *BoardInviteStatus `json:"status"`
+	CreatedAt *Timestamp         `json:"created_at"`
+}
+
+func (b BoardInvite) String() string {
+	return Stringify(b)
+}
+
+// BoardInvitesResponse represents the response for list board invites.
+type BoardInvitesResponse struct {
+	Items    []*BoardInvite `json:"items"`
+	Bookmark *string        `json:"bookmark"`
+}
+
+func (b BoardInvitesResponse) String() string {
+	return Stringify(b)
+}
+
+// ListBoardCollaborators Get a list of the collaborators on a board owned by the "operation user_account" - or on a group board that has been shared with this account.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/list_collaborators
+func (r *BoardResource) ListBoardCollaborators(boardID string, args ListOptions) (*BoardCollaboratorsResponse, error) {
+	return r.ListBoardCollaboratorsWithContext(context.Background(), boardID, args)
+}
+
+// ListBoardCollaboratorsWithContext is the same as ListBoardCollaborators, but with a context for the request.
+func (r *BoardResource) ListBoardCollaboratorsWithContext(ctx context.Context, boardID string, args ListOptions) (*BoardCollaboratorsResponse, error) {
+	path := "/boards/" + boardID + "/collaborators"
+
+	resp := new(BoardCollaboratorsResponse)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListBoardCollaboratorsIter Return an iterator walking through all the collaborators of the board, the pages are fetched by the bookmark.
+func (r *BoardResource) ListBoardCollaboratorsIter(boardID string, args ListOptions) *Iterator[*BoardCollaborator] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*BoardCollaborator, *string, error) {
+		resp, err := r.ListBoardCollaboratorsWithContext(ctx, boardID, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args)
+}
+
+// InviteBoardCollaboratorsOpts represents the parameters for invite board collaborators.
+type InviteBoardCollaboratorsOpts struct {
+	Usernames []string    `json:"usernames,omitempty"`
+	Emails    []string    `json:"emails,omitempty"`
+	Access    BoardAccess `json:"access,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (i InviteBoardCollaboratorsOpts) Validate() error {
+	if len(i.Usernames) == 0 && len(i.Emails) == 0 {
+		return &ValidationError{Field: "usernames", Message: "one of usernames and emails is required"}
+	}
+	return validateEnum("access", i.Access)
+}
+
+// InviteBoardCollaborators Invite users by username or email to collaborate on a board owned by the "operation user_account".
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/invite_collaborators
+func (r *BoardResource) InviteBoardCollaborators(boardID string, args InviteBoardCollaboratorsOpts) (*BoardInvitesResponse, error) {
+	return r.InviteBoardCollaboratorsWithContext(context.Background(), boardID, args)
+}
+
+// InviteBoardCollaboratorsWithContext is the same as InviteBoardCollaborators, but with a context for the request.
+func (r *BoardResource) InviteBoardCollaboratorsWithContext(ctx context.Context, boardID string, args InviteBoardCollaboratorsOpts) (*BoardInvitesResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/boards/" + boardID + "/collaborators/invites"
+
+	resp := new(BoardInvitesResponse)
+	err := r.Cli.DoPostWithContext(ctx, path, args, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateBoardCollaboratorOpts represents the parameters for update board collaborator.
+type UpdateBoardCollaboratorOpts struct {
+	Access BoardAccess `json:"access"`
+}
+
+// Validate Check the parameters before sending the request.
+func (u UpdateBoardCollaboratorOpts) Validate() error {
+	return validateEnum("access", u.Access)
+}
+
+// UpdateBoardCollaborator Update the access of a collaborator on a board owned by the "operation user_account".
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/update_collaborator
+func (r *BoardResource) UpdateBoardCollaborator(boardID, collaboratorID string, args UpdateBoardCollaboratorOpts) (*BoardCollaborator, error) {
+	return r.UpdateBoardCollaboratorWithContext(context.Background(), boardID, collaboratorID, args)
+}
+
+// UpdateBoardCollaboratorWithContext is the same as UpdateBoardCollaborator, but with a context for the request.
+func (r *BoardResource) UpdateBoardCollaboratorWithContext(ctx context.Context, boardID, collaboratorID string, args UpdateBoardCollaboratorOpts) (*BoardCollaborator, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/boards/" + boardID + "/collaborators/" + collaboratorID
+
+	resp := new(BoardCollaborator)
+	err := r.Cli.DoPatchWithContext(ctx, path, args, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RemoveBoardCollaborator Remove a collaborator from a board owned by the "operation user_account".
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/delete_collaborator
+func (r *BoardResource) RemoveBoardCollaborator(boardID, collaboratorID string) error {
+	return r.RemoveBoardCollaboratorWithContext(context.Background(), boardID, collaboratorID)
+}
+
+// RemoveBoardCollaboratorWithContext is the same as RemoveBoardCollaborator, but with a context for the request.
+func (r *BoardResource) RemoveBoardCollaboratorWithContext(ctx context.Context, boardID, collaboratorID string) error {
+	path := "/boards/" + boardID + "/collaborators/" + collaboratorID
+
+	err := r.Cli.DoDeleteWithContext(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListBoardInvites Get a list of the pending board invites for the "operation user_account".
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/list_invites
+func (r *BoardResource) ListBoardInvites(args ListOptions) (*BoardInvitesResponse, error) {
+	return r.ListBoardInvitesWithContext(context.Background(), args)
+}
+
+// ListBoardInvitesWithContext is the same as ListBoardInvites, but with a context for the request.
+func (r *BoardResource) ListBoardInvitesWithContext(ctx context.Context, args ListOptions) (*BoardInvitesResponse, error) {
+	path := "/boards/invites"
+
+	resp := new(BoardInvitesResponse)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListBoardInvitesIter Return an iterator walking through all the pending board invites, the pages are fetched by the bookmark.
+func (r *BoardResource) ListBoardInvitesIter(args ListOptions) *Iterator[*BoardInvite] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*BoardInvite, *string, error) {
+		resp, err := r.ListBoardInvitesWithContext(ctx, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args)
+}
+
+// respondBoardInviteOpts represents the parameters for respond a board invite.
+type respondBoardInviteOpts struct {
+	Status BoardInviteStatus `json:"status"`
+}
+
+// respondBoardInvite Accept or decline the board invite.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/boards/respond_invite
+func (r *BoardResource) respondBoardInvite(ctx context.Context, boardID, inviteID string, status BoardInviteStatus) (*BoardInvite, error) {
+	path := "/boards/" + boardID + "/invites/" + inviteID
+
+	resp := new(BoardInvite)
+	err := r.Cli.DoPatchWithContext(ctx, path, respondBoardInviteOpts{Status: status}, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AcceptBoardInvite Accept the invite to collaborate on a board.
+func (r *BoardResource) AcceptBoardInvite(boardID, inviteID string) (*BoardInvite, error) {
+	return r.AcceptBoardInviteWithContext(context.Background(), boardID, inviteID)
+}
+
+// AcceptBoardInviteWithContext is the same as AcceptBoardInvite, but with a context for the request.
+func (r *BoardResource) AcceptBoardInviteWithContext(ctx context.Context, boardID, inviteID string) (*BoardInvite, error) {
+	return r.respondBoardInvite(ctx, boardID, inviteID, BoardInviteStatusAccepted)
+}
+
+// DeclineBoardInvite Decline the invite to collaborate on a board.
+func (r *BoardResource) DeclineBoardInvite(boardID, inviteID string) (*BoardInvite, error) {
+	return r.DeclineBoardInviteWithContext(context.Background(), boardID, inviteID)
+}
+
+// DeclineBoardInviteWithContext is the same as DeclineBoardInvite, but with a context for the request.
+func (r *BoardResource) DeclineBoardInviteWithContext(ctx context.Context, boardID, inviteID string) (*BoardInvite, error) {
+	return r.respondBoardInvite(ctx, boardID, inviteID, BoardInviteStatusDeclined)
+}
@@ -0,0 +1,159 @@
+package pinterest
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jarcoal/httpmock"
+	"io"
+	"net/http"
+)
+
+func (bc *BCSuite) TestListBoardCollaborators() {
+	boardID := "1022106146619699845"
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/boards/"+boardID+"/collaborators",
+		httpmock.NewStringResponder(
+			403,
+			`{"code":403,"message":"Not authorized to access the board."}`,
+		),
+	)
+	_, err := bc.Pin.Board.ListBoardCollaborators(boardID, ListOptions{})
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/boards/"+boardID+"/collaborators",
+		httpmock.NewStringResponder(
+			200,
+			`{"items":[{"id":"1022106215339040474","username":"merleliukun","access":"DO_ALMOST_EVERYTHING"},{"id":"1022106215339040475","username":"kunliu","access":"SAVE_AND_COMMENT"}],"bookmark":null}`,
+		),
+	)
+
+	cs, _ := bc.Pin.Board.ListBoardCollaborators(boardID, ListOptions{})
+	bc.Equal(*cs.Items[0].Username, "merleliukun")
+	bc.Equal(*cs.Items[1].Access, BoardAccessSaveAndComment)
+	bc.Nil(cs.Bookmark)
+
+	items, err := bc.Pin.Board.ListBoardCollaboratorsIter(boardID, ListOptions{}).All(context.Background())
+	bc.Nil(err)
+	bc.Len(items, 2)
+}
+
+func (bc *BCSuite) TestInviteBoardCollaborators() {
+	boardID := "1022106146619699845"
+	_, err := bc.Pin.Board.InviteBoardCollaborators(boardID, InviteBoardCollaboratorsOpts{})
+	bc.IsType(&ValidationError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/boards/"+boardID+"/collaborators/invites",
+		httpmock.NewStringResponder(
+			400,
+			`{"code":400,"message":"Invalid invitee."}`,
+		),
+	)
+	_, err = bc.Pin.Board.InviteBoardCollaborators(boardID, InviteBoardCollaboratorsOpts{Usernames: []string{"kunliu"}})
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/boards/"+boardID+"/collaborators/invites",
+		httpmock.NewStringResponder(
+			201,
+			`{"items":[{"id":"5215150022519213435","board_id":"1022106146619699845","invitee":{"username":"kunliu"},"access":"SAVE_AND_COMMENT","status":"PENDING"},{"id":"5215150022519213436","board_id":"1022106146619699845","email":"kun@example.com","access":"SAVE_AND_COMMENT","status":"PENDING"}],"bookmark":null}`,
+		),
+	)
+
+	invites, _ := bc.Pin.Board.InviteBoardCollaborators(boardID, InviteBoardCollaboratorsOpts{
+		Usernames: []string{"kunliu"},
+		Emails:    []string{"kun@example.com"},
+		Access:    BoardAccessSaveAndComment,
+	})
+	bc.Equal(*invites.Items[0].Invitee.Username, "kunliu")
+	bc.Equal(*invites.Items[1].Email, "kun@example.com")
+	bc.Equal(*invites.Items[1].Status, BoardInviteStatusPending)
+}
+
+func (bc *BCSuite) TestUpdateBoardCollaborator() {
+	boardID := "1022106146619699845"
+	userID := "1022106215339040475"
+	httpmock.RegisterResponder(
+		HttpPatch, Baseurl+"/boards/"+boardID+"/collaborators/"+userID,
+		httpmock.NewStringResponder(
+			404,
+			`{"code":404,"message":"Collaborator not found."}`,
+		),
+	)
+	_, err := bc.Pin.Board.UpdateBoardCollaborator(boardID, userID, UpdateBoardCollaboratorOpts{Access: BoardAccessDoAlmostEverything})
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpPatch, Baseurl+"/boards/"+boardID+"/collaborators/"+userID,
+		httpmock.NewStringResponder(
+			200,
+			`{"id":"1022106215339040475","username":"kunliu","access":"DO_ALMOST_EVERYTHING"}`,
+		),
+	)
+
+	c, _ := bc.Pin.Board.UpdateBoardCollaborator(boardID, userID, UpdateBoardCollaboratorOpts{Access: BoardAccessDoAlmostEverything})
+	bc.Equal(*c.Access, BoardAccessDoAlmostEverything)
+}
+
+func (bc *BCSuite) TestRemoveBoardCollaborator() {
+	boardID := "1022106146619699845"
+	userID := "1022106215339040475"
+	httpmock.RegisterResponder(
+		HttpDelete, Baseurl+"/boards/"+boardID+"/collaborators/"+userID,
+		httpmock.NewStringResponder(
+			404,
+			`{"code":404,"message":"Collaborator not found."}`,
+		),
+	)
+	err := bc.Pin.Board.RemoveBoardCollaborator(boardID, userID)
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpDelete, Baseurl+"/boards/"+boardID+"/collaborators/"+userID,
+		httpmock.NewStringResponder(204, ``),
+	)
+
+	err = bc.Pin.Board.RemoveBoardCollaborator(boardID, userID)
+	bc.Nil(err)
+}
+
+func (bc *BCSuite) TestBoardInvites() {
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/boards/invites",
+		httpmock.NewStringResponder(
+			401,
+			`{"code":401,"message":"Authentication failed."}`,
+		),
+	)
+	_, err := bc.Pin.Board.ListBoardInvites(ListOptions{})
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/boards/invites",
+		httpmock.NewStringResponder(
+			200,
+			`{"items":[{"id":"5215150022519213435","board_id":"1022106146619699845","inviter":{"username":"merleliukun"},"access":"SAVE_AND_COMMENT","status":"PENDING"}],"bookmark":null}`,
+		),
+	)
+
+	invites, _ := bc.Pin.Board.ListBoardInvites(ListOptions{})
+	bc.Equal(*invites.Items[0].Inviter.Username, "merleliukun")
+
+	boardID, inviteID := *invites.Items[0].BoardID, *invites.Items[0].ID
+	httpmock.RegisterResponder(
+		HttpPatch, Baseurl+"/boards/"+boardID+"/invites/"+inviteID,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			var opts respondBoardInviteOpts
+			_ = json.Unmarshal(body, &opts)
+			return httpmock.NewStringResponse(200, `{"id":"`+inviteID+`","board_id":"`+boardID+`","status":"`+string(opts.Status)+`"}`), nil
+		},
+	)
+
+	invite, _ := bc.Pin.Board.AcceptBoardInvite(boardID, inviteID)
+	bc.Equal(*invite.Status, BoardInviteStatusAccepted)
+
+	invite, _ = bc.Pin.Board.DeclineBoardInvite(boardID, inviteID)
+	bc.Equal(*invite.Status, BoardInviteStatusDeclined)
+}
@@ -1,5 +1,7 @@
 package pinterest
 
+import "context"
+
 // BoardSection represents the board section info
 type BoardSection struct {
 	ID   *string `json:"id"`
@@ -22,17 +24,33 @@ func (b BoardSectionsResponse) String() string {
 
 // ListBoardSections Get a list of all board sections from a board owned by the "operation user_account" - or a group board that has been shared with this account.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/board_sections/list
-func (r *BoardResource) ListBoardSections(boardID string, args ListOptions) (*BoardSectionsResponse, *APIError) {
+func (r *BoardResource) ListBoardSections(boardID string, args ListOptions) (*BoardSectionsResponse, error) {
+	return r.ListBoardSectionsWithContext(context.Background(), boardID, args)
+}
+
+// ListBoardSectionsWithContext is the same as ListBoardSections, but with a context for the request.
+func (r *BoardResource) ListBoardSectionsWithContext(ctx context.Context, boardID string, args ListOptions) (*BoardSectionsResponse, error) {
 	path := "/boards/" + boardID + "/sections"
 
 	resp := new(BoardSectionsResponse)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// ListBoardSectionsIter Return an iterator walking through all the sections of the board, the pages are fetched by the bookmark.
+func (r *BoardResource) ListBoardSectionsIter(boardID string, args ListOptions) *Iterator[*BoardSection] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*BoardSection, *string, error) {
+		resp, err := r.ListBoardSectionsWithContext(ctx, boardID, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args)
+}
+
 // CreateBoardSectionOpts represents the parameter for create or update board section.
 type CreateBoardSectionOpts struct {
 	Name string `json:"name"`
@@ -40,11 +58,16 @@ type CreateBoardSectionOpts struct {
 
 // CreateBoardSection Create a board section on a board owned by the "operation user_account" - or on a group board that has been shared with this account.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/board_sections/create
-func (r *BoardResource) CreateBoardSection(boardID string, args CreateBoardSectionOpts) (*BoardSection, *APIError) {
+func (r *BoardResource) CreateBoardSection(boardID string, args CreateBoardSectionOpts) (*BoardSection, error) {
+	return r.CreateBoardSectionWithContext(context.Background(), boardID, args)
+}
+
+// CreateBoardSectionWithContext is the same as CreateBoardSection, but with a context for the request.
+func (r *BoardResource) CreateBoardSectionWithContext(ctx context.Context, boardID string, args CreateBoardSectionOpts) (*BoardSection, error) {
 	path := "/boards/" + boardID + "/sections"
 
 	resp := new(BoardSection)
-	err := r.Cli.DoPost(path, args, resp)
+	err := r.Cli.DoPostWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -53,11 +76,16 @@ func (r *BoardResource) CreateBoardSection(boardID string, args CreateBoardSecti
 
 // UpdateBoardSection Update a board section on a board owned by the "operation user_account" - or on a group board that has been shared with this account.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/board_sections/update
-func (r *BoardResource) UpdateBoardSection(boardID, sectionID string, args CreateBoardSectionOpts) (*BoardSection, *APIError) {
+func (r *BoardResource) UpdateBoardSection(boardID, sectionID string, args CreateBoardSectionOpts) (*BoardSection, error) {
+	return r.UpdateBoardSectionWithContext(context.Background(), boardID, sectionID, args)
+}
+
+// UpdateBoardSectionWithContext is the same as UpdateBoardSection, but with a context for the request.
+func (r *BoardResource) UpdateBoardSectionWithContext(ctx context.Context, boardID, sectionID string, args CreateBoardSectionOpts) (*BoardSection, error) {
 	path := "/boards/" + boardID + "/sections/" + sectionID
 
 	resp := new(BoardSection)
-	err := r.Cli.DoPatch(path, args, resp)
+	err := r.Cli.DoPatchWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -66,10 +94,15 @@ func (r *BoardResource) UpdateBoardSection(boardID, sectionID string, args Creat
 
 // DeleteBoardSection Delete a board section on a board owned by the "operation user_account" - or on a group board that has been shared with this account.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/board_sections/delete
-func (r *BoardResource) DeleteBoardSection(boardID, sectionID string) *APIError {
+func (r *BoardResource) DeleteBoardSection(boardID, sectionID string) error {
+	return r.DeleteBoardSectionWithContext(context.Background(), boardID, sectionID)
+}
+
+// DeleteBoardSectionWithContext is the same as DeleteBoardSection, but with a context for the request.
+func (r *BoardResource) DeleteBoardSectionWithContext(ctx context.Context, boardID, sectionID string) error {
 	path := "/boards/" + boardID + "/sections/" + sectionID
 
-	err := r.Cli.DoDelete(path, nil)
+	err := r.Cli.DoDeleteWithContext(ctx, path, nil)
 	if err != nil {
 		return err
 	}
@@ -78,13 +111,29 @@ func (r *BoardResource) DeleteBoardSection(boardID, sectionID string) *APIError
 
 // ListPinsOnBoardSection Get a list of the Pins on a board section of a board owned by the "operation user_account" - or on a group board that has been shared with this account.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/board_sections/list_pins
-func (r *BoardResource) ListPinsOnBoardSection(boardID, sectionID string, args ListOptions) (*PinsResponse, *APIError) {
+func (r *BoardResource) ListPinsOnBoardSection(boardID, sectionID string, args ListOptions) (*PinsResponse, error) {
+	return r.ListPinsOnBoardSectionWithContext(context.Background(), boardID, sectionID, args)
+}
+
+// ListPinsOnBoardSectionWithContext is the same as ListPinsOnBoardSection, but with a context for the request.
+func (r *BoardResource) ListPinsOnBoardSectionWithContext(ctx context.Context, boardID, sectionID string, args ListOptions) (*PinsResponse, error) {
 	path := "/boards/" + boardID + "/sections/" + sectionID + "/pins"
 
 	resp := new(PinsResponse)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
+
+// ListPinsOnBoardSectionIter Return an iterator walking through all the pins on the board section, the pages are fetched by the bookmark.
+func (r *BoardResource) ListPinsOnBoardSectionIter(boardID, sectionID string, args ListOptions) *Iterator[*Pin] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*Pin, *string, error) {
+		resp, err := r.ListPinsOnBoardSectionWithContext(ctx, boardID, sectionID, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args)
+}
@@ -3,6 +3,7 @@ package pinterest
 import (
 	"github.com/go-resty/resty/v2"
 	"net/http"
+	"sync"
 )
 
 const (
@@ -12,17 +13,27 @@ const (
 	HttpPatch  = resty.MethodPatch
 	HttpDelete = resty.MethodDelete
 
+	// OAuthState the constant state used by GetAuthorizationURL.
+	//
+	// Deprecated: use AuthorizationAPP.NewAuthorizationSession to get a random state.
 	OAuthState = "go-pinterest"
 )
 
 type Client struct {
 	Cli *resty.Client
+	// RetryPolicy for the failed requests, nil means no retry.
+	RetryPolicy *RetryPolicy
+
+	rateMu    sync.Mutex
+	rateLimit RateLimit
+	limiters  map[RateLimitCategory]*TokenBucket
 	// API Resource
 	UserAccount *UserAccountResource
 	Board       *BoardResource
 	Pin         *PinResource
 	Media       *MediaResource
 	AdAccount   *AdAccountResource
+	Conversion  *ConversionResource
 }
 
 type Resource struct {
@@ -38,6 +49,7 @@ func NewClient(client *resty.Client) *Client {
 	c.Pin = newPinResource(c)
 	c.Media = newMediaResource(c)
 	c.AdAccount = newAdAccountResource(c)
+	c.Conversion = newConversionResource(c)
 	return c
 }
 
@@ -68,3 +80,7 @@ func Int64(v int64) *int64 { return &v }
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
 func String(v string) *string { return &v }
+
+// Ptr is a helper routine that allocates a new value of any type, like
+// the enum types, to store v and returns a pointer to it.
+func Ptr[T any](v T) *T { return &v }
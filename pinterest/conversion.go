@@ -0,0 +1,341 @@
+package pinterest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+)
+
+/*
+	Conversions API
+	Refer: https://developers.pinterest.com/docs/api/v5/#tag/conversion_events
+*/
+
+// ConversionEventsMaxBatch the max events in a request.
+const ConversionEventsMaxBatch = 1000
+
+// ConversionResource sends the conversion events. Note the endpoint accepts the conversion access token of
+// the ad account, e.g. the client created by NewBearerClient with the conversion token.
+type ConversionResource Resource
+
+func newConversionResource(cli *Client) *ConversionResource {
+	return &ConversionResource{Cli: cli}
+}
+
+// ConversionEventName represents the name of the conversion event.
+type ConversionEventName string
+
+// Conversion event name
+const (
+	ConversionEventCheckout     ConversionEventName = "checkout"
+	ConversionEventAddToCart    ConversionEventName = "add_to_cart"
+	ConversionEventPageVisit    ConversionEventName = "page_visit"
+	ConversionEventSignup       ConversionEventName = "signup"
+	ConversionEventWatchVideo   ConversionEventName = "watch_video"
+	ConversionEventLead         ConversionEventName = "lead"
+	ConversionEventSearch       ConversionEventName = "search"
+	ConversionEventViewCategory ConversionEventName = "view_category"
+	ConversionEventCustom       ConversionEventName = "custom"
+)
+
+func (n ConversionEventName) IsValid() bool {
+	switch n {
+	case ConversionEventCheckout, ConversionEventAddToCart, ConversionEventPageVisit, ConversionEventSignup,
+		ConversionEventWatchVideo, ConversionEventLead, ConversionEventSearch, ConversionEventViewCategory,
+		ConversionEventCustom:
+		return true
+	}
+	return false
+}
+
+// ConversionActionSource represents where the conversion event happened.
+type ConversionActionSource string
+
+// Conversion action source
+const (
+	ConversionActionSourceAppAndroid ConversionActionSource = "app_android"
+	ConversionActionSourceAppIOS     ConversionActionSource = "app_ios"
+	ConversionActionSourceWeb        ConversionActionSource = "web"
+	ConversionActionSourceOffline    ConversionActionSource = "offline"
+)
+
+func (s ConversionActionSource) IsValid() bool {
+	switch s {
+	case ConversionActionSourceAppAndroid, ConversionActionSourceAppIOS, ConversionActionSourceWeb,
+		ConversionActionSourceOffline:
+		return true
+	}
+	return false
+}
+
+// ConversionUserData represents the user info of the conversion event.
+// The PII fields can be given in plain text, they are normalized and hashed with SHA-256 before sending.
+// The values already hashed, 64 hex characters, are sent as they are.
+type ConversionUserData struct {
+	Emails          []string `json:"em,omitempty"`
+	Phones          []string `json:"ph,omitempty"`
+	ExternalIDs     []string `json:"external_id,omitempty"`
+	FirstNames      []string `json:"fn,omitempty"`
+	LastNames       []string `json:"ln,omitempty"`
+	Genders         []string `json:"ge,omitempty"`
+	BirthDates      []string `json:"db,omitempty"`
+	Cities          []string `json:"ct,omitempty"`
+	States          []string `json:"st,omitempty"`
+	ZipCodes        []string `json:"zp,omitempty"`
+	Countries       []string `json:"country,omitempty"`
+	HashedMAIDs     []string `json:"hashed_maids,omitempty"`
+	ClientIPAddress string   `json:"client_ip_address,omitempty"`
+	ClientUserAgent string   `json:"client_user_agent,omitempty"`
+	ClickID         string   `json:"click_id,omitempty"`
+	PartnerID       string   `json:"partner_id,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (c ConversionUserData) Validate() error {
+	if len(c.Emails) == 0 && len(c.HashedMAIDs) == 0 && (c.ClientIPAddress == "" || c.ClientUserAgent == "") {
+		return &ValidationError{Field: "user_data", Message: "requires em, hashed_maids, or both client_ip_address and client_user_agent"}
+	}
+	return nil
+}
+
+// Hashed Return a copy of the user data with the PII fields normalized and hashed.
+func (c ConversionUserData) Hashed() ConversionUserData {
+	c.Emails = hashValues(c.Emails, HashEmail)
+	c.Phones = hashValues(c.Phones, HashPhone)
+	c.ExternalIDs = hashValues(c.ExternalIDs, HashExternalID)
+	for _, values := range []*[]string{&c.FirstNames, &c.LastNames, &c.Genders, &c.BirthDates, &c.Cities, &c.States, &c.ZipCodes, &c.Countries} {
+		*values = hashValues(*values, hashLower)
+	}
+	return c
+}
+
+// ConversionContent represents the product info of the conversion event.
+type ConversionContent struct {
+	ID        string `json:"id,omitempty"`
+	ItemPrice string `json:"item_price,omitempty"`
+	Quantity  int    `json:"quantity,omitempty"`
+}
+
+// ConversionCustomData represents the custom data of the conversion event, the Value is the total value
+// in the Currency, e.g. "72.39".
+type ConversionCustomData struct {
+	Currency        string               `json:"currency,omitempty"`
+	Value           string               `json:"value,omitempty"`
+	ContentIDs      []string             `json:"content_ids,omitempty"`
+	ContentName     string               `json:"content_name,omitempty"`
+	ContentCategory string               `json:"content_category,omitempty"`
+	ContentBrand    string               `json:"content_brand,omitempty"`
+	Contents        []*ConversionContent `json:"contents,omitempty"`
+	NumItems        int                  `json:"num_items,omitempty"`
+	OrderID         string               `json:"order_id,omitempty"`
+	SearchString    string               `json:"search_string,omitempty"`
+	OptOutType      string               `json:"opt_out_type,omitempty"`
+	NP              string               `json:"np,omitempty"`
+}
+
+// ConversionEvent represents the conversion event.
+type ConversionEvent struct {
+	EventName      ConversionEventName    `json:"event_name"`
+	ActionSource   ConversionActionSource `json:"action_source"`
+	EventTime      UnixTime               `json:"event_time"`
+	EventID        string                 `json:"event_id"`
+	EventSourceURL string                 `json:"event_source_url,omitempty"`
+	OptOut         *bool                  `json:"opt_out,omitempty"`
+	PartnerName    string                 `json:"partner_name,omitempty"`
+	UserData       ConversionUserData     `json:"user_data"`
+	CustomData     *ConversionCustomData  `json:"custom_data,omitempty"`
+	AppID          string                 `json:"app_id,omitempty"`
+	AppName        string                 `json:"app_name,omitempty"`
+	AppVersion     string                 `json:"app_version,omitempty"`
+	DeviceBrand    string                 `json:"device_brand,omitempty"`
+	DeviceCarrier  string                 `json:"device_carrier,omitempty"`
+	DeviceModel    string                 `json:"device_model,omitempty"`
+	DeviceType     string                 `json:"device_type,omitempty"`
+	OSVersion      string                 `json:"os_version,omitempty"`
+	Wifi           *bool                  `json:"wifi,omitempty"`
+	Language       string                 `json:"language,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (c ConversionEvent) Validate() error {
+	if c.EventName == "" {
+		return &ValidationError{Field: "event_name", Message: "required"}
+	}
+	if err := validateEnum("event_name", c.EventName); err != nil {
+		return err
+	}
+	if c.ActionSource == "" {
+		return &ValidationError{Field: "action_source", Message: "required"}
+	}
+	if err := validateEnum("action_source", c.ActionSource); err != nil {
+		return err
+	}
+	if c.EventTime.IsZero() {
+		return &ValidationError{Field: "event_time", Message: "required"}
+	}
+	if c.EventID == "" {
+		return &ValidationError{Field: "event_id", Message: "required"}
+	}
+	return c.UserData.Validate()
+}
+
+// ConversionEventResult represents the result of an event in the request.
+type ConversionEventResult struct {
+	Status         *string `json:"status"`
+	ErrorMessage   *string `json:"error_message"`
+	WarningMessage *string `json:"warning_message"`
+}
+
+func (c ConversionEventResult) String() string {
+	return Stringify(c)
+}
+
+// SendConversionEventsResponse represents the response for send the conversion events, the Events are in
+// the order of the sent events.
+type SendConversionEventsResponse struct {
+	NumEventsReceived  *int                     `json:"num_events_received"`
+	NumEventsProcessed *int                     `json:"num_events_processed"`
+	Events             []*ConversionEventResult `json:"events"`
+}
+
+func (s SendConversionEventsResponse) String() string {
+	return Stringify(s)
+}
+
+// merge Add the response of the next batch.
+func (s *SendConversionEventsResponse) merge(next *SendConversionEventsResponse) {
+	for _, count := range []struct{ dst, src **int }{
+		{&s.NumEventsReceived, &next.NumEventsReceived},
+		{&s.NumEventsProcessed, &next.NumEventsProcessed},
+	} {
+		if *count.src == nil {
+			continue
+		}
+		if *count.dst == nil {
+			*count.dst = Int(0)
+		}
+		**count.dst += **count.src
+	}
+	s.Events = append(s.Events, next.Events...)
+}
+
+// SendConversionEventsOpts represents the parameters for send the conversion events.
+type SendConversionEventsOpts struct {
+	// Test the events are validated but not recorded.
+	Test bool `url:"test,omitempty"`
+	// BatchSize the max events in a request, default to ConversionEventsMaxBatch.
+	BatchSize int `url:"-"`
+}
+
+type sendConversionEventsBody struct {
+	Data []*ConversionEvent `json:"data"`
+}
+
+// SendEvents Send the conversion events, the user data is hashed and the events are sent in batches.
+// If a batch failed, the responses of the sent batches are returned along with the error.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/events/create
+func (r *ConversionResource) SendEvents(adAccountID string, events []*ConversionEvent, args SendConversionEventsOpts) (*SendConversionEventsResponse, error) {
+	return r.SendEventsWithContext(context.Background(), adAccountID, events, args)
+}
+
+// SendEventsWithContext is the same as SendEvents, but with a context for the request.
+func (r *ConversionResource) SendEventsWithContext(ctx context.Context, adAccountID string, events []*ConversionEvent, args SendConversionEventsOpts) (*SendConversionEventsResponse, error) {
+	if len(events) == 0 {
+		return nil, &ValidationError{Field: "data", Message: "required"}
+	}
+	if args.BatchSize <= 0 || args.BatchSize > ConversionEventsMaxBatch {
+		args.BatchSize = ConversionEventsMaxBatch
+	}
+	hashed := make([]*ConversionEvent, len(events))
+	for i, event := range events {
+		if err := event.Validate(); err != nil {
+			return nil, batchValidationError(i, err)
+		}
+		e := *event
+		e.UserData = e.UserData.Hashed()
+		hashed[i] = &e
+	}
+	path := "/ad_accounts/" + adAccountID + "/events"
+
+	var resp *SendConversionEventsResponse
+	for start := 0; start < len(hashed); start += args.BatchSize {
+		end := start + args.BatchSize
+		if end > len(hashed) {
+			end = len(hashed)
+		}
+		batch := new(SendConversionEventsResponse)
+		err := r.Cli.DoWithContext(ctx, HttpPost, path, args, sendConversionEventsBody{Data: hashed[start:end]}, batch)
+		if err != nil {
+			return resp, err
+		}
+		if resp == nil {
+			resp = batch
+		} else {
+			resp.merge(batch)
+		}
+	}
+	return resp, nil
+}
+
+// HashEmail Return the SHA-256 hash of the email, trimmed and in lower case.
+func HashEmail(email string) string {
+	return sha256Hex(strings.ToLower(strings.TrimSpace(email)))
+}
+
+// HashPhone Return the SHA-256 hash of the phone number with the digits only and no leading zeros.
+// The number should include the country code, e.g. "+1 (555) 012-3456" is hashed as "15550123456".
+func HashPhone(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	return sha256Hex(strings.TrimLeft(digits, "0"))
+}
+
+// HashExternalID Return the SHA-256 hash of the external id, trimmed.
+func HashExternalID(id string) string {
+	return sha256Hex(strings.TrimSpace(id))
+}
+
+// hashLower Return the SHA-256 hash of the value, trimmed and in lower case.
+func hashLower(s string) string {
+	return sha256Hex(strings.ToLower(strings.TrimSpace(s)))
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// isSHA256 Check if the value is already a SHA-256 hash in hex.
+func isSHA256(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.Is(unicode.ASCII_Hex_Digit, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// hashValues Return the hashed values, the values already hashed are kept.
+func hashValues(values []string, hash func(string) string) []string {
+	if values == nil {
+		return nil
+	}
+	hashed := make([]string, len(values))
+	for i, v := range values {
+		if isSHA256(v) {
+			hashed[i] = strings.ToLower(v)
+		} else {
+			hashed[i] = hash(v)
+		}
+	}
+	return hashed
+}
@@ -0,0 +1,121 @@
+package pinterest
+
+import (
+	"encoding/json"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestHashPII(t *testing.T) {
+	assert.Equal(t, "973dfe463ec85785f5f95af5ba3906eedb2d931c24e69824a89ea65dba4e813b", HashEmail(" Test@Example.com "))
+	assert.Equal(t, HashEmail("test@example.com"), HashEmail("TEST@example.com"))
+	assert.Equal(t, sha256Hex("15550123456"), HashPhone("+1 (555) 012-3456"))
+	assert.Equal(t, sha256Hex("15550123456"), HashPhone("0015550123456"))
+	assert.Equal(t, sha256Hex("User-1"), HashExternalID(" User-1 "))
+
+	data := ConversionUserData{
+		Emails:          []string{"test@example.com", "973DFE463EC85785F5F95AF5BA3906EEDB2D931C24E69824A89EA65DBA4E813B"},
+		Phones:          []string{"+1 555 012 3456"},
+		ExternalIDs:     []string{"user-1"},
+		FirstNames:      []string{" John "},
+		ClientIPAddress: "127.0.0.1",
+	}
+	hashed := data.Hashed()
+	assert.Equal(t, []string{HashEmail("test@example.com"), HashEmail("test@example.com")}, hashed.Emails)
+	assert.Equal(t, []string{sha256Hex("15550123456")}, hashed.Phones)
+	assert.Equal(t, []string{sha256Hex("user-1")}, hashed.ExternalIDs)
+	assert.Equal(t, []string{sha256Hex("john")}, hashed.FirstNames)
+	assert.Equal(t, "127.0.0.1", hashed.ClientIPAddress)
+	assert.Nil(t, hashed.LastNames)
+	// the origin data is not changed
+	assert.Equal(t, "test@example.com", data.Emails[0])
+}
+
+func conversionEvent(id string) *ConversionEvent {
+	return &ConversionEvent{
+		EventName:    ConversionEventCheckout,
+		ActionSource: ConversionActionSourceWeb,
+		EventTime:    *NewUnixTime(time.Unix(1645339536, 0)),
+		EventID:      id,
+		UserData:     ConversionUserData{Emails: []string{"test@example.com"}},
+		CustomData: &ConversionCustomData{
+			Currency: "USD",
+			Value:    "72.39",
+			Contents: []*ConversionContent{{ID: "sku-1", ItemPrice: "72.39", Quantity: 1}},
+		},
+	}
+}
+
+func TestConversionEventValidate(t *testing.T) {
+	assert.Nil(t, conversionEvent("1").Validate())
+
+	event := conversionEvent("1")
+	event.EventName = "purchase"
+	assert.Equal(t, "event_name", event.Validate().(*ValidationError).Field)
+
+	event = conversionEvent("")
+	assert.Equal(t, &ValidationError{Field: "event_id", Message: "required"}, event.Validate())
+
+	event = conversionEvent("1")
+	event.UserData = ConversionUserData{ClientIPAddress: "127.0.0.1"}
+	assert.Equal(t, "user_data", event.Validate().(*ValidationError).Field)
+	event.UserData.ClientUserAgent = "Mozilla/5.0"
+	assert.Nil(t, event.Validate())
+}
+
+func (bc *BCSuite) TestSendConversionEvents() {
+	path := "/ad_accounts/549755885175/events"
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+path,
+		httpmock.NewStringResponder(401, `{"code":2,"message":"Authentication failed."}`),
+	)
+	_, err := bc.Pin.Conversion.SendEvents("549755885175", []*ConversionEvent{conversionEvent("1")}, SendConversionEventsOpts{})
+	bc.IsType(&APIError{}, err)
+
+	_, err = bc.Pin.Conversion.SendEvents("549755885175", []*ConversionEvent{conversionEvent("1"), conversionEvent("")}, SendConversionEventsOpts{})
+	bc.Equal(&ValidationError{Field: "items[1].event_id", Message: "required"}, err)
+
+	var (
+		batches [][]map[string]interface{}
+		queries []string
+	)
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+path,
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				Data []map[string]interface{} `json:"data"`
+			}
+			data, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(data, &body)
+			batches = append(batches, body.Data)
+			queries = append(queries, req.URL.RawQuery)
+			n := strconv.Itoa(len(body.Data))
+			events := `{"status":"processed","error_message":"","warning_message":""}`
+			if len(body.Data) == 2 {
+				events += "," + events
+			}
+			return httpmock.NewStringResponse(200, `{"num_events_received":`+n+`,"num_events_processed":`+n+`,"events":[`+events+`]}`), nil
+		},
+	)
+	events := []*ConversionEvent{conversionEvent("1"), conversionEvent("2"), conversionEvent("3")}
+	resp, err := bc.Pin.Conversion.SendEvents("549755885175", events, SendConversionEventsOpts{Test: true, BatchSize: 2})
+	bc.Nil(err)
+	bc.Equal(3, *resp.NumEventsReceived)
+	bc.Equal(3, *resp.NumEventsProcessed)
+	bc.Len(resp.Events, 3)
+	bc.Equal([]string{"test=true", "test=true"}, queries)
+	bc.Len(batches, 2)
+	bc.Len(batches[0], 2)
+	bc.Equal("3", batches[1][0]["event_id"])
+	bc.Equal(float64(1645339536), batches[0][0]["event_time"])
+	bc.Equal([]interface{}{HashEmail("test@example.com")}, batches[0][0]["user_data"].(map[string]interface{})["em"])
+	bc.Equal("72.39", batches[0][0]["custom_data"].(map[string]interface{})["value"])
+	// the events are not changed
+	bc.Equal("test@example.com", events[0].UserData.Emails[0])
+}
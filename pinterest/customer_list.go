@@ -0,0 +1,403 @@
+package pinterest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+	Customer Lists API
+	Refer: https://developers.pinterest.com/docs/api/v5/#tag/customer_lists
+*/
+
+// CustomerListMaxRecords the max records sent in a request, the more records are sent in chunks.
+const CustomerListMaxRecords = 100000
+
+// CustomerListType represents the type of the records in the customer list.
+type CustomerListType string
+
+// Customer list type
+const (
+	CustomerListTypeEmail          CustomerListType = "EMAIL"
+	CustomerListTypeIDFA           CustomerListType = "IDFA"
+	CustomerListTypeMAID           CustomerListType = "MAID"
+	CustomerListTypeLRID           CustomerListType = "LR_ID"
+	CustomerListTypeDLXID          CustomerListType = "DLX_ID"
+	CustomerListTypeHashedPinnerID CustomerListType = "HASHED_PINNER_ID"
+)
+
+func (t CustomerListType) IsValid() bool {
+	switch t {
+	case CustomerListTypeEmail, CustomerListTypeIDFA, CustomerListTypeMAID, CustomerListTypeLRID,
+		CustomerListTypeDLXID, CustomerListTypeHashedPinnerID:
+		return true
+	}
+	return false
+}
+
+// CustomerListStatus represents the status of the customer list.
+type CustomerListStatus string
+
+// Customer list status
+const (
+	CustomerListStatusProcessing CustomerListStatus = "PROCESSING"
+	CustomerListStatusReady      CustomerListStatus = "READY"
+	CustomerListStatusTooSmall   CustomerListStatus = "TOO_SMALL"
+	CustomerListStatusUploading  CustomerListStatus = "UPLOADING"
+)
+
+func (s CustomerListStatus) IsValid() bool {
+	switch s {
+	case CustomerListStatusProcessing, CustomerListStatusReady, CustomerListStatusTooSmall, CustomerListStatusUploading:
+		return true
+	}
+	return false
+}
+
+// CustomerListOperation represents the operation on the records of the customer list.
+type CustomerListOperation string
+
+// Customer list operation
+const (
+	CustomerListOperationAdd    CustomerListOperation = "ADD"
+	CustomerListOperationRemove CustomerListOperation = "REMOVE"
+)
+
+func (o CustomerListOperation) IsValid() bool {
+	return o == CustomerListOperationAdd || o == CustomerListOperationRemove
+}
+
+// CustomerList represents the customer list info.
+type CustomerList struct {
+	ID                     *string             `json:"id"`
+	AdAccountID            *string             `json:"ad_account_id"`
+	Name                   *string             `json:"name"`
+	Type                   *string             `json:"type"`
+	Status                 *CustomerListStatus `json:"status"`
+	NumBatches             *int                `json:"num_batches"`
+	NumUploadedUserRecords *int                `json:"num_uploaded_user_records"`
+	NumRemovedUserRecords  *int                `json:"num_removed_user_records"`
+	CreatedTime            *UnixTime           `json:"created_time"`
+	UpdatedTime            *UnixTime           `json:"updated_time"`
+}
+
+func (c CustomerList) String() string {
+	return Stringify(c)
+}
+
+// CustomerListsResponse represents the response for list customer lists.
+type CustomerListsResponse struct {
+	Items    []*CustomerList `json:"items"`
+	Bookmark *string         `json:"bookmark"`
+}
+
+func (c CustomerListsResponse) String() string {
+	return Stringify(c)
+}
+
+// ListCustomerListsOpts represents the parameters for list customer lists.
+type ListCustomerListsOpts struct {
+	Order string `url:"order,omitempty"`
+	ListOptions
+}
+
+// ListCustomerLists Get a list of the customer lists in the specified ad_account_id.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/customer_lists/list
+func (r *AdAccountResource) ListCustomerLists(adAccountID string, args ListCustomerListsOpts) (*CustomerListsResponse, error) {
+	return r.ListCustomerListsWithContext(context.Background(), adAccountID, args)
+}
+
+// ListCustomerListsWithContext is the same as ListCustomerLists, but with a context for the request.
+func (r *AdAccountResource) ListCustomerListsWithContext(ctx context.Context, adAccountID string, args ListCustomerListsOpts) (*CustomerListsResponse, error) {
+	path := "/ad_accounts/" + adAccountID + "/customer_lists"
+
+	resp := new(CustomerListsResponse)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListCustomerListsIter Return an iterator walking through all the customer lists, the pages are fetched by the bookmark.
+func (r *AdAccountResource) ListCustomerListsIter(adAccountID string, args ListCustomerListsOpts) *Iterator[*CustomerList] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*CustomerList, *string, error) {
+		args.ListOptions = opts
+		resp, err := r.ListCustomerListsWithContext(ctx, adAccountID, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args.ListOptions)
+}
+
+// GetCustomerList Get the customer list.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/customer_lists/get
+func (r *AdAccountResource) GetCustomerList(adAccountID, customerListID string) (*CustomerList, error) {
+	return r.GetCustomerListWithContext(context.Background(), adAccountID, customerListID)
+}
+
+// GetCustomerListWithContext is the same as GetCustomerList, but with a context for the request.
+func (r *AdAccountResource) GetCustomerListWithContext(ctx context.Context, adAccountID, customerListID string) (*CustomerList, error) {
+	path := "/ad_accounts/" + adAccountID + "/customer_lists/" + customerListID
+
+	resp := new(CustomerList)
+	err := r.Cli.DoGetWithContext(ctx, path, nil, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// validateRecords Check the records can be sent in a request.
+func validateRecords(records []string) error {
+	if len(records) == 0 {
+		return &ValidationError{Field: "records", Message: "required"}
+	}
+	if len(records) > CustomerListMaxRecords {
+		return &ValidationError{Field: "records", Message: fmt.Sprintf("more than %d records, send them in chunks", CustomerListMaxRecords)}
+	}
+	return nil
+}
+
+// HashCustomerRecords Return the records normalized and hashed with SHA-256 for the list type.
+// The emails and the MAIDs are trimmed and in lower case before hashing, the others are only trimmed.
+// The records already hashed are kept.
+func HashCustomerRecords(listType CustomerListType, records []string) []string {
+	switch listType {
+	case CustomerListTypeEmail:
+		return hashValues(records, HashEmail)
+	case CustomerListTypeMAID, CustomerListTypeIDFA:
+		return hashValues(records, hashLower)
+	}
+	trimmed := make([]string, len(records))
+	for i, record := range records {
+		trimmed[i] = strings.TrimSpace(record)
+	}
+	return trimmed
+}
+
+// CreateCustomerListOpts represents the parameters for create a customer list.
+// The Records are the plain text or hashed records of the ListType, they are hashed before sending.
+type CreateCustomerListOpts struct {
+	Name     string           `json:"name"`
+	Records  []string         `json:"-"`
+	ListType CustomerListType `json:"list_type"`
+}
+
+// Validate Check the parameters before sending the request.
+func (c CreateCustomerListOpts) Validate() error {
+	if c.Name == "" {
+		return &ValidationError{Field: "name", Message: "required"}
+	}
+	if c.ListType == "" {
+		return &ValidationError{Field: "list_type", Message: "required"}
+	}
+	if err := validateEnum("list_type", c.ListType); err != nil {
+		return err
+	}
+	return validateRecords(c.Records)
+}
+
+type createCustomerListBody struct {
+	CreateCustomerListOpts
+	Records string `json:"records"`
+}
+
+// CreateCustomerList Create a customer list with the records, at most CustomerListMaxRecords records.
+// Use UploadCustomerList for more records.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/customer_lists/create
+func (r *AdAccountResource) CreateCustomerList(adAccountID string, args CreateCustomerListOpts) (*CustomerList, error) {
+	return r.CreateCustomerListWithContext(context.Background(), adAccountID, args)
+}
+
+// CreateCustomerListWithContext is the same as CreateCustomerList, but with a context for the request.
+func (r *AdAccountResource) CreateCustomerListWithContext(ctx context.Context, adAccountID string, args CreateCustomerListOpts) (*CustomerList, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/ad_accounts/" + adAccountID + "/customer_lists"
+	body := createCustomerListBody{
+		CreateCustomerListOpts: args,
+		Records:                strings.Join(HashCustomerRecords(args.ListType, args.Records), ","),
+	}
+
+	resp := new(CustomerList)
+	err := r.Cli.DoPostWithContext(ctx, path, body, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateCustomerListOpts represents the parameters for update the records of a customer list.
+// The Records are hashed for the ListType before sending, they are sent as they are if the ListType is empty.
+type UpdateCustomerListOpts struct {
+	Records       []string              `json:"-"`
+	OperationType CustomerListOperation `json:"operation_type"`
+	ListType      CustomerListType      `json:"-"`
+}
+
+// Validate Check the parameters before sending the request.
+func (u UpdateCustomerListOpts) Validate() error {
+	if u.OperationType == "" {
+		return &ValidationError{Field: "operation_type", Message: "required"}
+	}
+	if err := validateEnum("operation_type", u.OperationType); err != nil {
+		return err
+	}
+	if err := validateEnum("list_type", u.ListType); err != nil {
+		return err
+	}
+	return validateRecords(u.Records)
+}
+
+type updateCustomerListBody struct {
+	UpdateCustomerListOpts
+	Records string `json:"records"`
+}
+
+// UpdateCustomerList Add or remove the records of the customer list, at most CustomerListMaxRecords records.
+// Use AddCustomerListRecords or RemoveCustomerListRecords for more records.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/customer_lists/update
+func (r *AdAccountResource) UpdateCustomerList(adAccountID, customerListID string, args UpdateCustomerListOpts) (*CustomerList, error) {
+	return r.UpdateCustomerListWithContext(context.Background(), adAccountID, customerListID, args)
+}
+
+// UpdateCustomerListWithContext is the same as UpdateCustomerList, but with a context for the request.
+func (r *AdAccountResource) UpdateCustomerListWithContext(ctx context.Context, adAccountID, customerListID string, args UpdateCustomerListOpts) (*CustomerList, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/ad_accounts/" + adAccountID + "/customer_lists/" + customerListID
+	records := args.Records
+	if args.ListType != "" {
+		records = HashCustomerRecords(args.ListType, records)
+	}
+	body := updateCustomerListBody{
+		UpdateCustomerListOpts: args,
+		Records:                strings.Join(records, ","),
+	}
+
+	resp := new(CustomerList)
+	err := r.Cli.DoPatchWithContext(ctx, path, body, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CustomerListProgress represents the progress of sending the records in chunks.
+type CustomerListProgress struct {
+	Operation CustomerListOperation
+	// Sent the records sent, including the current chunk.
+	Sent int
+	// Total the records to send.
+	Total int
+	// Chunk the number of the current chunk, starts from 1.
+	Chunk int
+	// Chunks the number of the chunks.
+	Chunks int
+}
+
+// CustomerListUploadOpts represents the options for send the records in chunks.
+type CustomerListUploadOpts struct {
+	// ChunkSize the max records in a request, default to CustomerListMaxRecords.
+	ChunkSize int
+	// Progress is called after each chunk is sent.
+	Progress func(progress CustomerListProgress)
+}
+
+// chunkRecords Split the records into the chunks of the size.
+func chunkRecords(records []string, size int) [][]string {
+	if size <= 0 || size > CustomerListMaxRecords {
+		size = CustomerListMaxRecords
+	}
+	var chunks [][]string
+	for start := 0; start < len(records); start += size {
+		end := start + size
+		if end > len(records) {
+			end = len(records)
+		}
+		chunks = append(chunks, records[start:end])
+	}
+	return chunks
+}
+
+// UploadCustomerList Create the customer list with the first chunk of the records, then add the others in chunks.
+// If a chunk failed, the created list is returned along with the error, the progress tells the records sent.
+func (r *AdAccountResource) UploadCustomerList(ctx context.Context, adAccountID string, args CreateCustomerListOpts, opts CustomerListUploadOpts) (*CustomerList, error) {
+	if len(args.Records) == 0 {
+		return nil, &ValidationError{Field: "records", Message: "required"}
+	}
+	chunks := chunkRecords(args.Records, opts.ChunkSize)
+	createArgs := args
+	createArgs.Records = chunks[0]
+	list, err := r.CreateCustomerListWithContext(ctx, adAccountID, createArgs)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Progress != nil {
+		opts.Progress(CustomerListProgress{
+			Operation: CustomerListOperationAdd, Sent: len(chunks[0]), Total: len(args.Records), Chunk: 1, Chunks: len(chunks),
+		})
+	}
+	if len(chunks) == 1 {
+		return list, nil
+	}
+	if list.ID == nil {
+		return list, errors.New("pinterest: no id for the created customer list")
+	}
+	updated, err := r.updateCustomerListChunks(ctx, adAccountID, *list.ID, CustomerListOperationAdd, args.ListType, chunks, 1, len(args.Records), opts)
+	if updated == nil {
+		return list, err
+	}
+	return updated, err
+}
+
+// AddCustomerListRecords Add the records to the customer list in chunks, the records are hashed for the list type.
+// If a chunk failed, the list of the last sent chunk is returned along with the error.
+func (r *AdAccountResource) AddCustomerListRecords(ctx context.Context, adAccountID, customerListID string, listType CustomerListType, records []string, opts CustomerListUploadOpts) (*CustomerList, error) {
+	return r.updateCustomerListChunks(ctx, adAccountID, customerListID, CustomerListOperationAdd, listType, chunkRecords(records, opts.ChunkSize), 0, len(records), opts)
+}
+
+// RemoveCustomerListRecords Remove the records from the customer list in chunks, the records are hashed for the list type.
+// If a chunk failed, the list of the last sent chunk is returned along with the error.
+func (r *AdAccountResource) RemoveCustomerListRecords(ctx context.Context, adAccountID, customerListID string, listType CustomerListType, records []string, opts CustomerListUploadOpts) (*CustomerList, error) {
+	return r.updateCustomerListChunks(ctx, adAccountID, customerListID, CustomerListOperationRemove, listType, chunkRecords(records, opts.ChunkSize), 0, len(records), opts)
+}
+
+// updateCustomerListChunks Send the chunks from the index skip, and report the progress.
+func (r *AdAccountResource) updateCustomerListChunks(ctx context.Context, adAccountID, customerListID string, operation CustomerListOperation, listType CustomerListType, chunks [][]string, skip, total int, opts CustomerListUploadOpts) (*CustomerList, error) {
+	if len(chunks) == 0 {
+		return nil, &ValidationError{Field: "records", Message: "required"}
+	}
+	if listType == "" {
+		return nil, &ValidationError{Field: "list_type", Message: "required"}
+	}
+
+	var list *CustomerList
+	sent := 0
+	for i, chunk := range chunks {
+		if i < skip {
+			sent += len(chunk)
+			continue
+		}
+		updated, err := r.UpdateCustomerListWithContext(ctx, adAccountID, customerListID, UpdateCustomerListOpts{
+			Records:       chunk,
+			OperationType: operation,
+			ListType:      listType,
+		})
+		if err != nil {
+			return list, err
+		}
+		list = updated
+		sent += len(chunk)
+		if opts.Progress != nil {
+			opts.Progress(CustomerListProgress{Operation: operation, Sent: sent, Total: total, Chunk: i + 1, Chunks: len(chunks)})
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,145 @@
+package pinterest
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestHashCustomerRecords(t *testing.T) {
+	assert.Equal(t, []string{HashEmail("test@example.com")}, HashCustomerRecords(CustomerListTypeEmail, []string{" Test@Example.com"}))
+	assert.Equal(t,
+		[]string{sha256Hex("6d92078a-8246-4ba4-ae5b-76104861e7dc")},
+		HashCustomerRecords(CustomerListTypeMAID, []string{"6D92078A-8246-4BA4-AE5B-76104861E7DC"}),
+	)
+	assert.Equal(t, []string{"lr-1"}, HashCustomerRecords(CustomerListTypeLRID, []string{" lr-1 "}))
+}
+
+func (bc *BCSuite) TestListCustomerLists() {
+	path := "/ad_accounts/549755885175/customer_lists"
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+path,
+		httpmock.NewStringResponder(401, `{"code":2,"message":"Authentication failed."}`),
+	)
+	_, err := bc.Pin.AdAccount.ListCustomerLists("549755885175", ListCustomerListsOpts{})
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+path,
+		httpmock.NewStringResponder(200, `{"items":[{"ad_account_id":"549755885175","created_time":1645339536,"id":"643","name":"The Glengarry Glen Ross leads","num_batches":2,"num_removed_user_records":0,"num_uploaded_user_records":10,"status":"PROCESSING","type":"customerlist","updated_time":1645339536}],"bookmark":null}`),
+	)
+	lists, err := bc.Pin.AdAccount.ListCustomerLists("549755885175", ListCustomerListsOpts{})
+	bc.Nil(err)
+	bc.Len(lists.Items, 1)
+	bc.Equal("643", *lists.Items[0].ID)
+	bc.Equal(CustomerListStatusProcessing, *lists.Items[0].Status)
+	bc.Equal(int64(1645339536), lists.Items[0].CreatedTime.Unix())
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+path+"/643",
+		httpmock.NewStringResponder(200, `{"ad_account_id":"549755885175","id":"643","name":"The Glengarry Glen Ross leads","num_uploaded_user_records":10,"status":"READY"}`),
+	)
+	list, err := bc.Pin.AdAccount.GetCustomerList("549755885175", "643")
+	bc.Nil(err)
+	bc.Equal(10, *list.NumUploadedUserRecords)
+	bc.Equal(CustomerListStatusReady, *list.Status)
+}
+
+func (bc *BCSuite) TestCreateCustomerList() {
+	path := "/ad_accounts/549755885175/customer_lists"
+
+	_, err := bc.Pin.AdAccount.CreateCustomerList("549755885175", CreateCustomerListOpts{Name: "leads", ListType: "PHONE", Records: []string{"1"}})
+	bc.Equal("list_type", err.(*ValidationError).Field)
+	_, err = bc.Pin.AdAccount.CreateCustomerList("549755885175", CreateCustomerListOpts{Name: "leads", ListType: CustomerListTypeEmail})
+	bc.Equal(&ValidationError{Field: "records", Message: "required"}, err)
+
+	var sent map[string]interface{}
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+path,
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(data, &sent)
+			return httpmock.NewStringResponse(200, `{"id":"643","name":"leads","status":"PROCESSING"}`), nil
+		},
+	)
+	list, err := bc.Pin.AdAccount.CreateCustomerList("549755885175", CreateCustomerListOpts{
+		Name:     "leads",
+		ListType: CustomerListTypeEmail,
+		Records:  []string{"a@example.com", "B@example.com"},
+	})
+	bc.Nil(err)
+	bc.Equal("643", *list.ID)
+	bc.Equal(map[string]interface{}{
+		"name":      "leads",
+		"list_type": "EMAIL",
+		"records":   HashEmail("a@example.com") + "," + HashEmail("b@example.com"),
+	}, sent)
+}
+
+func (bc *BCSuite) TestUploadCustomerList() {
+	path := "/ad_accounts/549755885175/customer_lists"
+
+	var (
+		created map[string]interface{}
+		updates []map[string]interface{}
+	)
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+path,
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(data, &created)
+			return httpmock.NewStringResponse(200, `{"id":"643","num_batches":1}`), nil
+		},
+	)
+	httpmock.RegisterResponder(
+		HttpPatch, Baseurl+path+"/643",
+		func(req *http.Request) (*http.Response, error) {
+			var update map[string]interface{}
+			data, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(data, &update)
+			if len(updates) == 2 {
+				return httpmock.NewStringResponse(500, `{"code":1,"message":"failed"}`), nil
+			}
+			updates = append(updates, update)
+			return httpmock.NewStringResponse(200, `{"id":"643","num_batches":`+strconv.Itoa(len(updates)+1)+`}`), nil
+		},
+	)
+
+	var progress []CustomerListProgress
+	opts := CustomerListUploadOpts{
+		ChunkSize: 2,
+		Progress:  func(p CustomerListProgress) { progress = append(progress, p) },
+	}
+	list, err := bc.Pin.AdAccount.UploadCustomerList(context.Background(), "549755885175", CreateCustomerListOpts{
+		Name:     "leads",
+		ListType: CustomerListTypeEmail,
+		Records:  []string{"1@example.com", "2@example.com", "3@example.com", "4@example.com", "5@example.com"},
+	}, opts)
+	bc.Nil(err)
+	bc.Equal(3, *list.NumBatches)
+	bc.Equal(2, len(strings.Split(created["records"].(string), ",")))
+	bc.Len(updates, 2)
+	bc.Equal("ADD", updates[0]["operation_type"])
+	bc.Equal(HashEmail("5@example.com"), updates[1]["records"])
+	bc.Equal([]CustomerListProgress{
+		{Operation: CustomerListOperationAdd, Sent: 2, Total: 5, Chunk: 1, Chunks: 3},
+		{Operation: CustomerListOperationAdd, Sent: 4, Total: 5, Chunk: 2, Chunks: 3},
+		{Operation: CustomerListOperationAdd, Sent: 5, Total: 5, Chunk: 3, Chunks: 3},
+	}, progress)
+
+	// the third chunk failed, the list of the second chunk is returned
+	progress, updates = nil, nil
+	list, err = bc.Pin.AdAccount.RemoveCustomerListRecords(context.Background(), "549755885175", "643", CustomerListTypeEmail, []string{"1", "2", "3", "4", "5"}, opts)
+	bc.IsType(&APIError{}, err)
+	bc.Equal(3, *list.NumBatches)
+	bc.Equal("REMOVE", updates[0]["operation_type"])
+	bc.Len(progress, 2)
+	bc.Equal(4, progress[1].Sent)
+}
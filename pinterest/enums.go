@@ -0,0 +1,382 @@
+package pinterest
+
+import (
+	"fmt"
+	"regexp"
+)
+
+/*
+	Enum types for the documented api values
+*/
+
+// Privacy represents the privacy of a board.
+type Privacy string
+
+// Board privacy
+const (
+	PrivacyPublic          Privacy = "PUBLIC"
+	PrivacyProtected       Privacy = "PROTECTED"
+	PrivacySecret          Privacy = "SECRET"
+	PrivacyAll             Privacy = "ALL"
+	PrivacyPublicAndSecret Privacy = "PUBLIC_AND_SECRET"
+)
+
+// IsValid Check if the privacy is a documented value. ALL and PUBLIC_AND_SECRET are only valid to filter boards.
+func (p Privacy) IsValid() bool {
+	switch p {
+	case PrivacyPublic, PrivacyProtected, PrivacySecret, PrivacyAll, PrivacyPublicAndSecret:
+		return true
+	}
+	return false
+}
+
+// EntityStatus represents the status of campaigns, ad groups and ads.
+type EntityStatus string
+
+// Entity status
+const (
+	EntityStatusActive       EntityStatus = "ACTIVE"
+	EntityStatusPaused       EntityStatus = "PAUSED"
+	EntityStatusArchived     EntityStatus = "ARCHIVED"
+	EntityStatusDraft        EntityStatus = "DRAFT"
+	EntityStatusDeletedDraft EntityStatus = "DELETED_DRAFT"
+)
+
+func (s EntityStatus) IsValid() bool {
+	switch s {
+	case EntityStatusActive, EntityStatusPaused, EntityStatusArchived, EntityStatusDraft, EntityStatusDeletedDraft:
+		return true
+	}
+	return false
+}
+
+// BudgetType represents the budget type of ad groups.
+type BudgetType string
+
+// Budget type
+const (
+	BudgetTypeDaily      BudgetType = "DAILY"
+	BudgetTypeLifetime   BudgetType = "LIFETIME"
+	BudgetTypeCBOAdGroup BudgetType = "CBO_ADGROUP"
+)
+
+func (t BudgetType) IsValid() bool {
+	switch t {
+	case BudgetTypeDaily, BudgetTypeLifetime, BudgetTypeCBOAdGroup:
+		return true
+	}
+	return false
+}
+
+// ObjectiveType represents the objective type of campaigns.
+type ObjectiveType string
+
+// Campaign objective type
+const (
+	ObjectiveAwareness       ObjectiveType = "AWARENESS"
+	ObjectiveConsideration   ObjectiveType = "CONSIDERATION"
+	ObjectiveVideoView       ObjectiveType = "VIDEO_VIEW"
+	ObjectiveWebConversion   ObjectiveType = "WEB_CONVERSION"
+	ObjectiveCatalogSales    ObjectiveType = "CATALOG_SALES"
+	ObjectiveWebSessions     ObjectiveType = "WEB_SESSIONS"
+	ObjectiveVideoCompletion ObjectiveType = "VIDEO_COMPLETION"
+)
+
+func (t ObjectiveType) IsValid() bool {
+	switch t {
+	case ObjectiveAwareness, ObjectiveConsideration, ObjectiveVideoView, ObjectiveWebConversion, ObjectiveCatalogSales,
+		ObjectiveWebSessions, ObjectiveVideoCompletion:
+		return true
+	}
+	return false
+}
+
+// BillableEvent represents the event the ad group is charged for.
+type BillableEvent string
+
+// Ad group billable event
+const (
+	BillableEventClickthrough BillableEvent = "CLICKTHROUGH"
+	BillableEventImpression   BillableEvent = "IMPRESSION"
+	BillableEventVideoV50MRC  BillableEvent = "VIDEO_V_50_MRC"
+)
+
+func (e BillableEvent) IsValid() bool {
+	return e == BillableEventClickthrough || e == BillableEventImpression || e == BillableEventVideoV50MRC
+}
+
+// CreativeType represents the creative type of pins and ads.
+type CreativeType string
+
+// Ad creative type
+const (
+	CreativeTypeRegular    CreativeType = "REGULAR"
+	CreativeTypeVideo      CreativeType = "VIDEO"
+	CreativeTypeShopping   CreativeType = "SHOPPING"
+	CreativeTypeCarousel   CreativeType = "CAROUSEL"
+	CreativeTypeMaxVideo   CreativeType = "MAX_VIDEO"
+	CreativeTypeShopThePin CreativeType = "SHOP_THE_PIN"
+	CreativeTypeIdea       CreativeType = "IDEA"
+	CreativeTypeCollection CreativeType = "COLLECTION"
+)
+
+func (t CreativeType) IsValid() bool {
+	switch t {
+	case CreativeTypeRegular, CreativeTypeVideo, CreativeTypeShopping, CreativeTypeCarousel, CreativeTypeMaxVideo,
+		CreativeTypeShopThePin, CreativeTypeIdea, CreativeTypeCollection:
+		return true
+	}
+	return false
+}
+
+// AdReviewStatus represents the review status of ads.
+type AdReviewStatus string
+
+// Ad review status
+const (
+	AdReviewStatusOther    AdReviewStatus = "OTHER"
+	AdReviewStatusPending  AdReviewStatus = "PENDING"
+	AdReviewStatusRejected AdReviewStatus = "REJECTED"
+	AdReviewStatusApproved AdReviewStatus = "APPROVED"
+)
+
+func (s AdReviewStatus) IsValid() bool {
+	switch s {
+	case AdReviewStatusOther, AdReviewStatusPending, AdReviewStatusRejected, AdReviewStatusApproved:
+		return true
+	}
+	return false
+}
+
+// PinSourceType represents the source type of the pin media.
+type PinSourceType string
+
+// Pin media source type
+const (
+	PinSourceImageURL            PinSourceType = "image_url"
+	PinSourceImageBase64         PinSourceType = "image_base64"
+	PinSourceVideoID             PinSourceType = "video_id"
+	PinSourceMultipleImageURLs   PinSourceType = "multiple_image_urls"
+	PinSourceMultipleImageBase64 PinSourceType = "multiple_image_base64"
+	PinSourcePinURL              PinSourceType = "pin_url"
+)
+
+func (t PinSourceType) IsValid() bool {
+	switch t {
+	case PinSourceImageURL, PinSourceImageBase64, PinSourceVideoID, PinSourceMultipleImageURLs, PinSourceMultipleImageBase64, PinSourcePinURL:
+		return true
+	}
+	return false
+}
+
+// MediaType represents the type of the media upload.
+type MediaType string
+
+// Media upload type
+const (
+	MediaTypeVideo MediaType = "video"
+)
+
+func (t MediaType) IsValid() bool {
+	return t == MediaTypeVideo
+}
+
+// MediaUploadStatus represents the processing status of the media upload.
+type MediaUploadStatus string
+
+// Media upload status
+const (
+	MediaUploadStatusRegistered MediaUploadStatus = "registered"
+	MediaUploadStatusProcessing MediaUploadStatus = "processing"
+	MediaUploadStatusSucceeded  MediaUploadStatus = "succeeded"
+	MediaUploadStatusFailed     MediaUploadStatus = "failed"
+)
+
+func (s MediaUploadStatus) IsValid() bool {
+	switch s {
+	case MediaUploadStatusRegistered, MediaUploadStatusProcessing, MediaUploadStatusSucceeded, MediaUploadStatusFailed:
+		return true
+	}
+	return false
+}
+
+// BoardAccess represents the access of a board collaborator.
+type BoardAccess string
+
+// Board collaborator access
+const (
+	BoardAccessSaveAndComment     BoardAccess = "SAVE_AND_COMMENT"
+	BoardAccessDoAlmostEverything BoardAccess = "DO_ALMOST_EVERYTHING"
+)
+
+func (a BoardAccess) IsValid() bool {
+	return a == BoardAccessSaveAndComment || a == BoardAccessDoAlmostEverything
+}
+
+// BoardInviteStatus represents the status of a board invite.
+type BoardInviteStatus string
+
+// Board invite status
+const (
+	BoardInviteStatusPending  BoardInviteStatus = "PENDING"
+	BoardInviteStatusAccepted BoardInviteStatus = "ACCEPTED"
+	BoardInviteStatusDeclined BoardInviteStatus = "DECLINED"
+)
+
+func (s BoardInviteStatus) IsValid() bool {
+	switch s {
+	case BoardInviteStatusPending, BoardInviteStatusAccepted, BoardInviteStatusDeclined:
+		return true
+	}
+	return false
+}
+
+// Granularity represents the granularity of the analytics.
+type Granularity string
+
+// Analytics granularity
+const (
+	GranularityTotal Granularity = "TOTAL"
+	GranularityDay   Granularity = "DAY"
+	GranularityHour  Granularity = "HOUR"
+	GranularityWeek  Granularity = "WEEK"
+	GranularityMonth Granularity = "MONTH"
+)
+
+func (g Granularity) IsValid() bool {
+	switch g {
+	case GranularityTotal, GranularityDay, GranularityHour, GranularityWeek, GranularityMonth:
+		return true
+	}
+	return false
+}
+
+// AnalyticsColumn represents the column to request in the ads analytics.
+type AnalyticsColumn string
+
+// Ads analytics column
+const (
+	ColumnAdAccountID                          AnalyticsColumn = "AD_ACCOUNT_ID"
+	ColumnAdvertiserID                         AnalyticsColumn = "ADVERTISER_ID"
+	ColumnCampaignID                           AnalyticsColumn = "CAMPAIGN_ID"
+	ColumnCampaignName                         AnalyticsColumn = "CAMPAIGN_NAME"
+	ColumnCampaignEntityStatus                 AnalyticsColumn = "CAMPAIGN_ENTITY_STATUS"
+	ColumnCampaignDailySpendCap                AnalyticsColumn = "CAMPAIGN_DAILY_SPEND_CAP"
+	ColumnCampaignLifetimeSpendCap             AnalyticsColumn = "CAMPAIGN_LIFETIME_SPEND_CAP"
+	ColumnAdGroupID                            AnalyticsColumn = "AD_GROUP_ID"
+	ColumnAdGroupEntityStatus                  AnalyticsColumn = "AD_GROUP_ENTITY_STATUS"
+	ColumnAdID                                 AnalyticsColumn = "AD_ID"
+	ColumnPinID                                AnalyticsColumn = "PIN_ID"
+	ColumnPinPromotionID                       AnalyticsColumn = "PIN_PROMOTION_ID"
+	ColumnProductGroupID                       AnalyticsColumn = "PRODUCT_GROUP_ID"
+	ColumnSpendInMicroDollar                   AnalyticsColumn = "SPEND_IN_MICRO_DOLLAR"
+	ColumnSpendInDollar                        AnalyticsColumn = "SPEND_IN_DOLLAR"
+	ColumnPaidImpression                       AnalyticsColumn = "PAID_IMPRESSION"
+	ColumnCPCInMicroDollar                     AnalyticsColumn = "CPC_IN_MICRO_DOLLAR"
+	ColumnECPCInMicroDollar                    AnalyticsColumn = "ECPC_IN_MICRO_DOLLAR"
+	ColumnECPCInDollar                         AnalyticsColumn = "ECPC_IN_DOLLAR"
+	ColumnCPMInMicroDollar                     AnalyticsColumn = "CPM_IN_MICRO_DOLLAR"
+	ColumnCPMInDollar                          AnalyticsColumn = "CPM_IN_DOLLAR"
+	ColumnECPMInMicroDollar                    AnalyticsColumn = "ECPM_IN_MICRO_DOLLAR"
+	ColumnECPEInDollar                         AnalyticsColumn = "ECPE_IN_DOLLAR"
+	ColumnCTR                                  AnalyticsColumn = "CTR"
+	ColumnECTR                                 AnalyticsColumn = "ECTR"
+	ColumnEngagementRate                       AnalyticsColumn = "ENGAGEMENT_RATE"
+	ColumnEEngagementRate                      AnalyticsColumn = "EENGAGEMENT_RATE"
+	ColumnTotalEngagement                      AnalyticsColumn = "TOTAL_ENGAGEMENT"
+	ColumnEngagement1                          AnalyticsColumn = "ENGAGEMENT_1"
+	ColumnEngagement2                          AnalyticsColumn = "ENGAGEMENT_2"
+	ColumnTotalImpression                      AnalyticsColumn = "TOTAL_IMPRESSION"
+	ColumnImpression1                          AnalyticsColumn = "IMPRESSION_1"
+	ColumnImpression2                          AnalyticsColumn = "IMPRESSION_2"
+	ColumnTotalClickthrough                    AnalyticsColumn = "TOTAL_CLICKTHROUGH"
+	ColumnClickthrough1                        AnalyticsColumn = "CLICKTHROUGH_1"
+	ColumnClickthrough2                        AnalyticsColumn = "CLICKTHROUGH_2"
+	ColumnOutboundClick1                       AnalyticsColumn = "OUTBOUND_CLICK_1"
+	ColumnOutboundClick2                       AnalyticsColumn = "OUTBOUND_CLICK_2"
+	ColumnTotalRepinRate                       AnalyticsColumn = "TOTAL_REPIN_RATE"
+	ColumnRepin1                               AnalyticsColumn = "REPIN_1"
+	ColumnRepin2                               AnalyticsColumn = "REPIN_2"
+	ColumnTotalConversions                     AnalyticsColumn = "TOTAL_CONVERSIONS"
+	ColumnTotalCheckout                        AnalyticsColumn = "TOTAL_CHECKOUT"
+	ColumnTotalCheckoutValueInMicroDollar      AnalyticsColumn = "TOTAL_CHECKOUT_VALUE_IN_MICRO_DOLLAR"
+	ColumnTotalClickCheckoutValueInMicroDollar AnalyticsColumn = "TOTAL_CLICK_CHECKOUT_VALUE_IN_MICRO_DOLLAR"
+	ColumnTotalViewCheckoutValueInMicroDollar  AnalyticsColumn = "TOTAL_VIEW_CHECKOUT_VALUE_IN_MICRO_DOLLAR"
+	ColumnTotalClickCheckout                   AnalyticsColumn = "TOTAL_CLICK_CHECKOUT"
+	ColumnTotalViewCheckout                    AnalyticsColumn = "TOTAL_VIEW_CHECKOUT"
+	ColumnTotalEngagementCheckout              AnalyticsColumn = "TOTAL_ENGAGEMENT_CHECKOUT"
+	ColumnTotalSignup                          AnalyticsColumn = "TOTAL_SIGNUP"
+	ColumnTotalLead                            AnalyticsColumn = "TOTAL_LEAD"
+	ColumnTotalPageVisit                       AnalyticsColumn = "TOTAL_PAGE_VISIT"
+	ColumnTotalAddToCart                       AnalyticsColumn = "TOTAL_ADD_TO_CART"
+	ColumnTotalCustom                          AnalyticsColumn = "TOTAL_CUSTOM"
+	ColumnTotalWatchVideo                      AnalyticsColumn = "TOTAL_WATCH_VIDEO"
+	ColumnTotalWebSessions                     AnalyticsColumn = "TOTAL_WEB_SESSIONS"
+	ColumnWebSessions1                         AnalyticsColumn = "WEB_SESSIONS_1"
+	ColumnWebSessions2                         AnalyticsColumn = "WEB_SESSIONS_2"
+	ColumnTotalVideo3SecViews                  AnalyticsColumn = "TOTAL_VIDEO_3SEC_VIEWS"
+	ColumnTotalVideoMRCViews                   AnalyticsColumn = "TOTAL_VIDEO_MRC_VIEWS"
+	ColumnTotalVideoP25Combined                AnalyticsColumn = "TOTAL_VIDEO_P25_COMBINED"
+	ColumnTotalVideoP50Combined                AnalyticsColumn = "TOTAL_VIDEO_P50_COMBINED"
+	ColumnTotalVideoP75Combined                AnalyticsColumn = "TOTAL_VIDEO_P75_COMBINED"
+	ColumnTotalVideoP95Combined                AnalyticsColumn = "TOTAL_VIDEO_P95_COMBINED"
+	ColumnTotalVideoP100Complete               AnalyticsColumn = "TOTAL_VIDEO_P100_COMPLETE"
+	ColumnTotalVideoAvgWatchtimeInSecond       AnalyticsColumn = "TOTAL_VIDEO_AVG_WATCHTIME_IN_SECOND"
+	ColumnVideo3SecViews2                      AnalyticsColumn = "VIDEO_3SEC_VIEWS_2"
+	ColumnVideoMRCViews2                       AnalyticsColumn = "VIDEO_MRC_VIEWS_2"
+	ColumnVideoStart2                          AnalyticsColumn = "VIDEO_START_2"
+	ColumnVideoLength                          AnalyticsColumn = "VIDEO_LENGTH"
+	ColumnCheckoutROAS                         AnalyticsColumn = "CHECKOUT_ROAS"
+	ColumnInAppCheckoutCostPerAction           AnalyticsColumn = "INAPP_CHECKOUT_COST_PER_ACTION"
+	ColumnCostPerOutboundClickInDollar         AnalyticsColumn = "COST_PER_OUTBOUND_CLICK_IN_DOLLAR"
+	ColumnCostPerVideoViewInDollar             AnalyticsColumn = "COST_PER_VIDEO_VIEW_IN_DOLLAR"
+	ColumnTotalOfflineCheckout                 AnalyticsColumn = "TOTAL_OFFLINE_CHECKOUT"
+	ColumnCTR2                                 AnalyticsColumn = "CTR_2"
+	ColumnTotalImpressionFrequency             AnalyticsColumn = "TOTAL_IMPRESSION_FREQUENCY"
+	ColumnTotalImpressionUser                  AnalyticsColumn = "TOTAL_IMPRESSION_USER"
+	ColumnOutboundCTR1                         AnalyticsColumn = "OUTBOUND_CTR_1"
+	ColumnPageVisitCostPerAction               AnalyticsColumn = "PAGE_VISIT_COST_PER_ACTION"
+	ColumnPageVisitROAS                        AnalyticsColumn = "PAGE_VISIT_ROAS"
+	ColumnTotalPageVisitValueInMicroDollar     AnalyticsColumn = "TOTAL_PAGE_VISIT_VALUE_IN_MICRO_DOLLAR"
+)
+
+// analyticsColumnPattern the format of the column names, the API has too many columns to list them all here.
+var analyticsColumnPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+
+// IsValid Check if the column is in the format of the column names, upper snake case. The unknown columns are
+// rejected by the API.
+func (c AnalyticsColumn) IsValid() bool {
+	return analyticsColumnPattern.MatchString(string(c))
+}
+
+// validateEnum Return a ValidationError for the field if the value is set and not valid.
+func validateEnum[T interface {
+	~string
+	IsValid() bool
+}](field string, value T) error {
+	if value != "" && !value.IsValid() {
+		return &ValidationError{Field: field, Message: fmt.Sprintf("unknown value %q", string(value))}
+	}
+	return nil
+}
+
+// validateEnums is the same as validateEnum, but for a list of values.
+func validateEnums[T interface {
+	~string
+	IsValid() bool
+}](field string, values []T) error {
+	for i, v := range values {
+		if err := validateEnum(fmt.Sprintf("%s[%d]", field, i), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateAnalytics Check the columns and the granularity of the ads analytics parameters.
+func validateAnalytics(columns []AnalyticsColumn, granularity Granularity) error {
+	if err := validateEnums("columns", columns); err != nil {
+		return err
+	}
+	return validateEnum("granularity", granularity)
+}
@@ -0,0 +1,68 @@
+package pinterest
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnumIsValid(t *testing.T) {
+	assert.True(t, PrivacySecret.IsValid())
+	assert.False(t, Privacy("PRIVATE").IsValid())
+	assert.True(t, EntityStatusPaused.IsValid())
+	assert.False(t, EntityStatus("paused").IsValid())
+	assert.True(t, BudgetTypeCBOAdGroup.IsValid())
+	assert.False(t, BudgetType("WEEKLY").IsValid())
+	assert.True(t, PinSourceMultipleImageURLs.IsValid())
+	assert.False(t, PinSourceType("image").IsValid())
+	assert.True(t, MediaTypeVideo.IsValid())
+	assert.False(t, MediaType("image").IsValid())
+	assert.True(t, GranularityWeek.IsValid())
+	assert.False(t, Granularity("YEAR").IsValid())
+	assert.True(t, ColumnSpendInMicroDollar.IsValid())
+	assert.True(t, AnalyticsColumn("TOTAL_VIEW_CHECKOUT_VALUE_IN_DOLLAR").IsValid())
+	assert.False(t, AnalyticsColumn("spend").IsValid())
+	assert.False(t, AnalyticsColumn("SPEND__IN_DOLLAR").IsValid())
+	assert.True(t, MetricSaveRate.IsValid())
+	assert.False(t, MetricType("SAVES").IsValid())
+}
+
+func TestValidateOpts(t *testing.T) {
+	var tests = []struct {
+		opts  interface{ Validate() error }
+		field string
+	}{
+		{ListBoardOpts{Privacy: PrivacyPublicAndSecret}, ""},
+		{CreateBoardOpts{Name: "City", Privacy: "public"}, "privacy"},
+		{ListCampaignsOpts{EntityStatuses: []EntityStatus{EntityStatusActive, "RUNNING"}}, "entity_statuses[1]"},
+		{GetAdAccountAnalyticsOpts{Columns: []AnalyticsColumn{ColumnSpendInDollar}, Granularity: GranularityDay}, ""},
+		{GetCampaignAnalyticsOpts{Columns: []AnalyticsColumn{"spend"}, Granularity: GranularityDay}, "columns[0]"},
+		{GetCampaignAnalyticsOpts{Columns: []AnalyticsColumn{ColumnTotalClickCheckoutValueInMicroDollar, "TOTAL_VIEW_CHECKOUT_VALUE_IN_DOLLAR"}}, ""},
+		{GetAdAnalyticsOpts{Columns: []AnalyticsColumn{ColumnSpendInDollar}, Granularity: "DAILY"}, "granularity"},
+		{RegisterMediaUploadOpts{}, "media_type"},
+		{RegisterMediaUploadOpts{MediaType: "image"}, "media_type"},
+		{GetPinAnalyticsOpts{MetricTypes: []MetricType{MetricImpression, "CLICK"}}, "metric_types[1]"},
+		{InviteBoardCollaboratorsOpts{Usernames: []string{"kunliu"}, Access: "ADMIN"}, "access"},
+	}
+
+	for i, tt := range tests {
+		err := tt.opts.Validate()
+		if tt.field == "" {
+			assert.Nil(t, err, i)
+			continue
+		}
+		var vErr *ValidationError
+		if assert.True(t, errors.As(err, &vErr), i) {
+			assert.Equal(t, tt.field, vErr.Field, i)
+		}
+	}
+}
+
+func (bc *BCSuite) TestValidateBeforeRequest() {
+	// No responder is registered, the validation error is returned without the request.
+	_, err := bc.Pin.Board.CreateBoard(CreateBoardOpts{Name: "City", Privacy: "PRIVATE"})
+	bc.IsType(&ValidationError{}, err)
+
+	_, err = bc.Pin.AdAccount.GetAdGroupAnalytics("549755885175", GetAdGroupAnalyticsOpts{Granularity: "YEAR"})
+	bc.IsType(&ValidationError{}, err)
+}
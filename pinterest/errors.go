@@ -0,0 +1,110 @@
+package pinterest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+/*
+	Errors for the requests
+*/
+
+// Sentinel errors for the common failures, use errors.Is to check an error returned by the client.
+var (
+	ErrNotFound       = errors.New("pinterest: resource not found")
+	ErrUnauthorized   = errors.New("pinterest: unauthorized")
+	ErrForbiddenScope = errors.New("pinterest: forbidden, the token may lack the required scope")
+	ErrRateLimited    = errors.New("pinterest: rate limited")
+)
+
+// APIError represents the error response
+type APIError struct {
+	Code         int    `json:"code"`
+	Message      string `json:"message"`
+	Status       string `json:"status,omitempty"`
+	Data         string `json:"data,omitempty"`
+	EndpointName string `json:"endpoint_name,omitempty"`
+	// StatusCode the http status code of the response
+	StatusCode int `json:"-"`
+	// RequestID the id of the request assigned by Pinterest, useful for the support.
+	RequestID string `json:"-"`
+	// RateLimit the rate limit info of the failed response
+	RateLimit *RateLimit `json:"-"`
+}
+
+func (e APIError) Error() string {
+	return fmt.Sprintf("Pinterest Error, Code: %d Message: %s", e.Code, e.Message)
+}
+
+func (e APIError) String() string {
+	return Stringify(e)
+}
+
+// Unwrap Return the sentinel error matching the http status code, so errors.Is(err, ErrNotFound) works.
+func (e *APIError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbiddenScope
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// TransportError represents the failure when the request could not get a response.
+type TransportError struct {
+	Method string
+	URL    string
+	Err    error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("Pinterest Error, %s %s: %v", e.Method, e.URL, e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError represents the failure when decoding the response body.
+type DecodeError struct {
+	StatusCode int
+	Body       []byte
+	Err        error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("Pinterest Error, decode response with status %d: %v", e.StatusCode, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// EncodeError represents the failure when encoding the parameters for the request.
+type EncodeError struct {
+	Err error
+}
+
+func (e *EncodeError) Error() string {
+	return fmt.Sprintf("Pinterest Error, encode parameters: %v", e.Err)
+}
+
+func (e *EncodeError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError represents the invalid parameters found before sending the request.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("Pinterest Error, invalid %s: %s", e.Field, e.Message)
+}
@@ -0,0 +1,308 @@
+package pinterest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+	Send the conversion events in the background
+*/
+
+var (
+	// ErrEventSenderClosed is returned when sending an event after the sender is closed.
+	ErrEventSenderClosed = errors.New("pinterest: event sender closed")
+	// ErrEventQueueFull is returned when the queue of the sender is full, the event is dropped.
+	ErrEventQueueFull = errors.New("pinterest: event queue full")
+)
+
+// EventSenderOpts represents the options for the event sender.
+type EventSenderOpts struct {
+	// BatchSize the max events in a request, default to ConversionEventsMaxBatch.
+	BatchSize int
+	// FlushInterval the max time an event waits in the queue, default to 5 seconds.
+	FlushInterval time.Duration
+	// QueueSize the max events waiting to be sent, default to 10 times of the BatchSize.
+	QueueSize int
+	// DedupeWindow how long an event id is remembered to drop the duplicated events, default to 10 minutes.
+	DedupeWindow time.Duration
+	// Retry the policy for the failed batches, only the MaxAttempts, the delays, RetryableStatusCodes and
+	// RetryTransportErrors are used. Default to DefaultRetryPolicy with one second base delay.
+	Retry *RetryPolicy
+	// Test send the events in test mode.
+	Test bool
+	// OnError is called with the events of the batch failed after the retries.
+	OnError func(events []*ConversionEvent, err error)
+}
+
+// EventSenderStats represents the counters of the event sender.
+type EventSenderStats struct {
+	// Sent the events processed by Pinterest.
+	Sent int64
+	// Failed the events failed to send after the retries, or rejected by Pinterest.
+	Failed int64
+	// Dropped the events not sent, because the queue is full or the sender is closed before sending.
+	Dropped int64
+	// Duplicated the events dropped because the event id is already sent.
+	Duplicated int64
+}
+
+func (e EventSenderStats) String() string {
+	return Stringify(e)
+}
+
+// EventSender queues the conversion events and sends them in batches in the background, the batch is sent
+// when it is full or the flush interval passed. It is safe for concurrent use, and must be closed to send
+// the queued events.
+type EventSender struct {
+	// The counters are the first to be aligned for the atomic operations.
+	stats EventSenderStats
+
+	r           *ConversionResource
+	adAccountID string
+	opts        EventSenderOpts
+
+	mu        sync.Mutex
+	closed    bool
+	seen      map[string]time.Time
+	lastPrune time.Time
+
+	queue   chan *ConversionEvent
+	flushes chan chan struct{}
+	done    chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+// NewEventSender Return a started event sender for the ad account.
+func (r *ConversionResource) NewEventSender(adAccountID string, opts EventSenderOpts) *EventSender {
+	if opts.BatchSize <= 0 || opts.BatchSize > ConversionEventsMaxBatch {
+		opts.BatchSize = ConversionEventsMaxBatch
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = 5 * time.Second
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = opts.BatchSize * 10
+	}
+	if opts.DedupeWindow <= 0 {
+		opts.DedupeWindow = 10 * time.Minute
+	}
+	if opts.Retry == nil {
+		opts.Retry = DefaultRetryPolicy()
+		opts.Retry.BaseDelay = time.Second
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &EventSender{
+		r:           r,
+		adAccountID: adAccountID,
+		opts:        opts,
+		seen:        make(map[string]time.Time),
+		lastPrune:   time.Now(),
+		queue:       make(chan *ConversionEvent, opts.QueueSize),
+		flushes:     make(chan chan struct{}),
+		done:        make(chan struct{}),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+	go s.run()
+	return s
+}
+
+// Send Queue the event without waiting, the events with an event id sent in the dedupe window are ignored.
+// ErrEventQueueFull is returned if the queue is full.
+func (s *EventSender) Send(event *ConversionEvent) error {
+	if err := event.Validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		atomic.AddInt64(&s.stats.Dropped, 1)
+		return ErrEventSenderClosed
+	}
+
+	now := time.Now()
+	if now.Sub(s.lastPrune) > s.opts.DedupeWindow {
+		for id, t := range s.seen {
+			if now.Sub(t) > s.opts.DedupeWindow {
+				delete(s.seen, id)
+			}
+		}
+		s.lastPrune = now
+	}
+	if t, ok := s.seen[event.EventID]; ok && now.Sub(t) <= s.opts.DedupeWindow {
+		atomic.AddInt64(&s.stats.Duplicated, 1)
+		return nil
+	}
+
+	select {
+	case s.queue <- event:
+		s.seen[event.EventID] = now
+		return nil
+	default:
+		atomic.AddInt64(&s.stats.Dropped, 1)
+		return ErrEventQueueFull
+	}
+}
+
+// Flush Send the queued events now and wait until they are sent.
+func (s *EventSender) Flush(ctx context.Context) error {
+	ack := make(chan struct{})
+	select {
+	case s.flushes <- ack:
+	case <-s.done:
+		return ErrEventSenderClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-ack:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close Stop accepting the events and wait until the queued events are sent. If the context is done
+// before that, the sending is cancelled and the events left are dropped.
+func (s *EventSender) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		s.cancel()
+		<-s.done
+		return ctx.Err()
+	}
+}
+
+// Stats Return the counters of the sender.
+func (s *EventSender) Stats() EventSenderStats {
+	return EventSenderStats{
+		Sent:       atomic.LoadInt64(&s.stats.Sent),
+		Failed:     atomic.LoadInt64(&s.stats.Failed),
+		Dropped:    atomic.LoadInt64(&s.stats.Dropped),
+		Duplicated: atomic.LoadInt64(&s.stats.Duplicated),
+	}
+}
+
+func (s *EventSender) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.opts.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*ConversionEvent, 0, s.opts.BatchSize)
+	send := func() {
+		if len(batch) > 0 {
+			s.sendBatch(batch)
+			batch = make([]*ConversionEvent, 0, s.opts.BatchSize)
+		}
+	}
+	for {
+		select {
+		case event, ok := <-s.queue:
+			if !ok {
+				send()
+				return
+			}
+			if batch = append(batch, event); len(batch) >= s.opts.BatchSize {
+				send()
+			}
+		case <-ticker.C:
+			send()
+		case ack := <-s.flushes:
+			for n := len(s.queue); n > 0; n-- {
+				event, ok := <-s.queue
+				if !ok {
+					break
+				}
+				if batch = append(batch, event); len(batch) >= s.opts.BatchSize {
+					send()
+				}
+			}
+			send()
+			close(ack)
+		}
+	}
+}
+
+// sendBatch Send the batch with retries, and update the counters. The batch cancelled by Close is dropped.
+func (s *EventSender) sendBatch(batch []*ConversionEvent) {
+	for attempt := 1; ; attempt++ {
+		if s.ctx.Err() != nil {
+			s.drop(batch)
+			return
+		}
+		resp, err := s.r.SendEventsWithContext(s.ctx, s.adAccountID, batch, SendConversionEventsOpts{Test: s.opts.Test, BatchSize: len(batch)})
+		if err == nil {
+			processed := int64(len(batch))
+			if resp.NumEventsProcessed != nil && int64(*resp.NumEventsProcessed) < processed {
+				processed = int64(*resp.NumEventsProcessed)
+			}
+			atomic.AddInt64(&s.stats.Sent, processed)
+			atomic.AddInt64(&s.stats.Failed, int64(len(batch))-processed)
+			return
+		}
+
+		delay, retry := s.retryDelay(attempt, err)
+		if retry && sleepContext(s.ctx, delay) == nil {
+			continue
+		}
+		if s.ctx.Err() != nil {
+			s.drop(batch)
+			return
+		}
+		atomic.AddInt64(&s.stats.Failed, int64(len(batch)))
+		s.forget(batch)
+		if s.opts.OnError != nil {
+			s.opts.OnError(batch, err)
+		}
+		return
+	}
+}
+
+// drop Count the batch as dropped, the events can be sent again.
+func (s *EventSender) drop(batch []*ConversionEvent) {
+	atomic.AddInt64(&s.stats.Dropped, int64(len(batch)))
+	s.forget(batch)
+}
+
+// forget Remove the event ids of the batch not sent, so the events can be sent again.
+func (s *EventSender) forget(batch []*ConversionEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, event := range batch {
+		delete(s.seen, event.EventID)
+	}
+}
+
+// retryDelay Return whether the failed batch should be retried after the attempt, and the delay before it.
+func (s *EventSender) retryDelay(attempt int, err error) (time.Duration, bool) {
+	p := s.opts.Retry
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return p.backoff(attempt), p.isRetryableStatus(apiErr.StatusCode)
+	}
+	var transportErr *TransportError
+	if errors.As(err, &transportErr) && !errors.Is(err, context.Canceled) {
+		return p.backoff(attempt), p.RetryTransportErrors
+	}
+	return 0, false
+}
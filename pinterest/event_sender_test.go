@@ -0,0 +1,166 @@
+package pinterest
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jarcoal/httpmock"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// eventsResponder Return a responder records the event ids of the batches, the first failures requests are
+// responded with the status code.
+func eventsResponder(mu *sync.Mutex, batches *[][]string, failures int, code int) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failures > 0 {
+			failures--
+			return httpmock.NewStringResponse(code, `{"code":1,"message":"failed"}`), nil
+		}
+		var body struct {
+			Data []*ConversionEvent `json:"data"`
+		}
+		data, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(data, &body)
+		var ids []string
+		for _, event := range body.Data {
+			ids = append(ids, event.EventID)
+		}
+		*batches = append(*batches, ids)
+		n := strconv.Itoa(len(ids))
+		return httpmock.NewStringResponse(200, `{"num_events_received":`+n+`,"num_events_processed":`+n+`}`), nil
+	}
+}
+
+func (bc *BCSuite) TestEventSender() {
+	var (
+		mu      sync.Mutex
+		batches [][]string
+	)
+	httpmock.RegisterResponder(HttpPost, Baseurl+"/ad_accounts/549755885175/events", eventsResponder(&mu, &batches, 0, 0))
+
+	sender := bc.Pin.Conversion.NewEventSender("549755885175", EventSenderOpts{BatchSize: 2, FlushInterval: time.Hour})
+	for _, id := range []string{"1", "2", "1", "3"} {
+		bc.Nil(sender.Send(conversionEvent(id)))
+	}
+	bc.IsType(&ValidationError{}, sender.Send(conversionEvent("")))
+	bc.Nil(sender.Close(context.Background()))
+	bc.Equal(ErrEventSenderClosed, sender.Send(conversionEvent("4")))
+
+	bc.Equal([][]string{{"1", "2"}, {"3"}}, batches)
+	bc.Equal(EventSenderStats{Sent: 3, Dropped: 1, Duplicated: 1}, sender.Stats())
+}
+
+func (bc *BCSuite) TestEventSenderFlush() {
+	var (
+		mu      sync.Mutex
+		batches [][]string
+	)
+	httpmock.RegisterResponder(HttpPost, Baseurl+"/ad_accounts/549755885175/events", eventsResponder(&mu, &batches, 0, 0))
+
+	sender := bc.Pin.Conversion.NewEventSender("549755885175", EventSenderOpts{FlushInterval: time.Hour})
+	defer sender.Close(context.Background())
+	bc.Nil(sender.Send(conversionEvent("1")))
+	bc.Nil(sender.Send(conversionEvent("2")))
+	bc.Nil(sender.Flush(context.Background()))
+	bc.Equal([][]string{{"1", "2"}}, batches)
+
+	// the queued events are sent by the interval without flush
+	received := make(chan struct{}, 1)
+	responder := eventsResponder(&mu, &batches, 0, 0)
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/549755885175/events",
+		func(req *http.Request) (*http.Response, error) {
+			resp, err := responder(req)
+			received <- struct{}{}
+			return resp, err
+		},
+	)
+	sender = bc.Pin.Conversion.NewEventSender("549755885175", EventSenderOpts{FlushInterval: 10 * time.Millisecond})
+	bc.Nil(sender.Send(conversionEvent("3")))
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		bc.FailNow("the events are not sent by the interval")
+	}
+	bc.Nil(sender.Close(context.Background()))
+	bc.Equal([][]string{{"1", "2"}, {"3"}}, batches)
+	bc.Equal(EventSenderStats{Sent: 1}, sender.Stats())
+}
+
+func (bc *BCSuite) TestEventSenderRetry() {
+	var (
+		mu      sync.Mutex
+		batches [][]string
+	)
+	httpmock.RegisterResponder(HttpPost, Baseurl+"/ad_accounts/549755885175/events", eventsResponder(&mu, &batches, 2, 503))
+
+	retry := DefaultRetryPolicy()
+	retry.BaseDelay = time.Millisecond
+	sender := bc.Pin.Conversion.NewEventSender("549755885175", EventSenderOpts{FlushInterval: time.Hour, Retry: retry})
+	bc.Nil(sender.Send(conversionEvent("1")))
+	bc.Nil(sender.Close(context.Background()))
+	bc.Equal([][]string{{"1"}}, batches)
+	bc.Equal(EventSenderStats{Sent: 1}, sender.Stats())
+
+	// the bad request is not retried, and the event can be sent again
+	httpmock.RegisterResponder(HttpPost, Baseurl+"/ad_accounts/549755885175/events", eventsResponder(&mu, &batches, 1, 400))
+	var failed []*ConversionEvent
+	sender = bc.Pin.Conversion.NewEventSender("549755885175", EventSenderOpts{
+		FlushInterval: time.Hour,
+		Retry:         retry,
+		OnError:       func(events []*ConversionEvent, err error) { failed = events },
+	})
+	bc.Nil(sender.Send(conversionEvent("2")))
+	bc.Nil(sender.Flush(context.Background()))
+	bc.Len(failed, 1)
+	bc.Nil(sender.Send(conversionEvent("2")))
+	bc.Nil(sender.Close(context.Background()))
+	bc.Equal(EventSenderStats{Sent: 1, Failed: 1}, sender.Stats())
+}
+
+func (bc *BCSuite) TestEventSenderQueueFull() {
+	block := make(chan struct{})
+	received := make(chan struct{}, 1)
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/ad_accounts/549755885175/events",
+		func(req *http.Request) (*http.Response, error) {
+			received <- struct{}{}
+			select {
+			case <-block:
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			}
+			return httpmock.NewStringResponse(200, `{"num_events_received":1,"num_events_processed":1}`), nil
+		},
+	)
+
+	failed := false
+	sender := bc.Pin.Conversion.NewEventSender("549755885175", EventSenderOpts{
+		BatchSize:     1,
+		QueueSize:     1,
+		FlushInterval: time.Hour,
+		OnError:       func(events []*ConversionEvent, err error) { failed = true },
+	})
+	// the first event is being sent, the second is queued
+	bc.Nil(sender.Send(conversionEvent("1")))
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		bc.FailNow("the first event is not sent")
+	}
+	bc.Nil(sender.Send(conversionEvent("2")))
+	bc.Equal(ErrEventQueueFull, sender.Send(conversionEvent("3")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	bc.Equal(context.DeadlineExceeded, sender.Close(ctx))
+	close(block)
+	// the events lost to the shutdown are dropped, not failed
+	bc.Equal(EventSenderStats{Dropped: 3}, sender.Stats())
+	bc.False(failed)
+}
@@ -0,0 +1,172 @@
+package pinterest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"golang.org/x/oauth2"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+/*
+	Loopback login for the CLI and desktop tools
+*/
+
+// ErrLoginTimeout is returned when the user not completes the authorization in time.
+var ErrLoginTimeout = errors.New("pinterest: timeout waiting for the authorization callback")
+
+// DefaultLoopbackSuccessPage the page shown in the browser after the authorization completed.
+const DefaultLoopbackSuccessPage = `<!DOCTYPE html>
+<html><head><title>Authorized</title></head>
+<body><p>Authorization completed, you can close this window now.</p></body></html>`
+
+// LoopbackLoginOpts represents the parameters for login with loopback.
+type LoopbackLoginOpts struct {
+	// Addr the address to listen on. If empty, the host and port of the app RedirectURI are used
+	// when it is a loopback url, otherwise a random port on 127.0.0.1.
+	// Note the redirect uri must be registered in the app settings.
+	Addr string
+	// Path the path of the callback, default to the path of the app RedirectURI or "/".
+	Path string
+	// Timeout for waiting the user to authorize, default to 5 minutes.
+	Timeout time.Duration
+	// PKCE whether to use the Proof Key for Code Exchange.
+	PKCE bool
+	// SuccessPage the html shown after the authorization completed, default to DefaultLoopbackSuccessPage.
+	SuccessPage string
+	// OpenURL is called with the authorization url, e.g. to open the browser.
+	// Default to print the url to stderr.
+	OpenURL func(authURL string) error
+}
+
+// loopbackAddr Return the listen address, the callback path and the redirect uri for the options.
+// The redirect uri is the app RedirectURI unchanged when the address is from it, as Pinterest requires the exact
+// registered uri. It is empty when the port is random, the caller builds it from the listener.
+func (app *AuthorizationAPP) loopbackAddr(opts LoopbackLoginOpts) (string, string, string) {
+	addr, path := opts.Addr, opts.Path
+	fromApp := addr == "" && path == ""
+	if u, err := url.Parse(app.RedirectURI); err == nil && u.Port() != "" {
+		host := u.Hostname()
+		if ip := net.ParseIP(host); host == "localhost" || (ip != nil && ip.IsLoopback()) {
+			if addr == "" {
+				addr = u.Host
+			}
+			if path == "" {
+				path = u.Path
+			}
+		} else {
+			fromApp = false
+		}
+	} else {
+		fromApp = false
+	}
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	if path == "" {
+		path = "/"
+	}
+
+	if fromApp {
+		return addr, path, app.RedirectURI
+	}
+	if _, port, err := net.SplitHostPort(addr); err == nil && port != "" && port != "0" {
+		return addr, path, "http://" + addr + path
+	}
+	return addr, path, ""
+}
+
+type loopbackResult struct {
+	token *oauth2.Token
+	err   error
+}
+
+// LoginWithLoopback Start a temporary http server on the loopback address as the redirect uri, open the
+// authorization url, wait for the callback, verify the state and exchange the code for the token.
+func (app *AuthorizationAPP) LoginWithLoopback(ctx context.Context, opts LoopbackLoginOpts) (*oauth2.Token, error) {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Minute
+	}
+	if opts.SuccessPage == "" {
+		opts.SuccessPage = DefaultLoopbackSuccessPage
+	}
+	if opts.OpenURL == nil {
+		opts.OpenURL = func(authURL string) error {
+			_, err := fmt.Fprintf(os.Stderr, "Open the url in your browser to authorize: %s\n", authURL)
+			return err
+		}
+	}
+
+	addr, path, redirectURI := app.loopbackAddr(opts)
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if redirectURI == "" {
+		redirectURI = "http://" + listener.Addr().String() + path
+	}
+
+	// Use a copy of the app with the loopback redirect uri.
+	config := *app.Config
+	config.RedirectURL = redirectURI
+	loopback := *app
+	loopback.Config = &config
+
+	session, err := loopback.NewAuthorizationSession(AuthorizationSessionOpts{PKCE: opts.PKCE})
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	results := make(chan loopbackResult, 1)
+	callback := loopback.CallbackHandler(
+		func(r *http.Request) (*AuthorizationSession, error) { return session, nil },
+		func(w http.ResponseWriter, r *http.Request, token *oauth2.Token, err error) {
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			} else {
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				w.Write([]byte(opts.SuccessPage))
+			}
+			select {
+			case results <- loopbackResult{token: token, err: err}:
+			default:
+			}
+		},
+	)
+	// Only the redirect request completes the login, others like the favicon are ignored.
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != path || (query.Get("code") == "" && query.Get("error") == "" && query.Get("state") == "") {
+			http.NotFound(w, r)
+			return
+		}
+		callback.ServeHTTP(w, r)
+	})
+	server := &http.Server{Handler: handler}
+	go server.Serve(listener)
+	defer server.Close()
+
+	if err = opts.OpenURL(session.URL); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(opts.Timeout)
+	defer timer.Stop()
+	select {
+	case result := <-results:
+		if result.err != nil {
+			return nil, result.err
+		}
+		app.Token = result.token
+		return result.token, nil
+	case <-timer.C:
+		return nil, ErrLoginTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,144 @@
+package pinterest
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func newLoopbackTestApp(t *testing.T) (*AuthorizationAPP, *string) {
+	verifier := new(string)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		*verifier = r.PostForm.Get("code_verifier")
+		if r.PostForm.Get("code") != "good" {
+			http.Error(w, `{"code":1,"message":"invalid code"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":3600,"refresh_token":"refresh"}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	app := NewAuthorizationAPP(AuthorizationAPP{ClientID: "client id", ClientSecret: "client secret"})
+	app.Config.Endpoint.TokenURL = ts.URL
+	return app, verifier
+}
+
+// fakeBrowser Return an OpenURL func which redirects back with the code, and records the page.
+func fakeBrowser(code string, stateFor func(string) string, page *string) func(string) error {
+	return func(authURL string) error {
+		u, _ := url.Parse(authURL)
+		q := u.Query()
+		resp, err := http.Get(q.Get("redirect_uri") + "?code=" + code + "&state=" + stateFor(q.Get("state")))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		*page = string(body)
+		return nil
+	}
+}
+
+func TestLoginWithLoopback(t *testing.T) {
+	app, verifier := newLoopbackTestApp(t)
+
+	var page string
+	token, err := app.LoginWithLoopback(context.Background(), LoopbackLoginOpts{
+		PKCE:        true,
+		SuccessPage: "done",
+		OpenURL:     fakeBrowser("good", func(s string) string { return s }, &page),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "token", token.AccessToken)
+	assert.Equal(t, token, app.Token)
+	assert.Equal(t, "done", page)
+	assert.NotEmpty(t, *verifier)
+	// the app config is not changed
+	assert.Equal(t, "", app.Config.RedirectURL)
+
+	_, err = app.LoginWithLoopback(context.Background(), LoopbackLoginOpts{
+		OpenURL: fakeBrowser("good", func(s string) string { return "forged" }, &page),
+	})
+	assert.Equal(t, ErrInvalidState, err)
+	assert.Contains(t, page, "invalid oauth2 state")
+
+	_, err = app.LoginWithLoopback(context.Background(), LoopbackLoginOpts{
+		OpenURL: fakeBrowser("bad", func(s string) string { return s }, &page),
+	})
+	assert.NotNil(t, err)
+}
+
+func TestLoginWithLoopbackTimeout(t *testing.T) {
+	app, _ := newLoopbackTestApp(t)
+	noop := func(string) error { return nil }
+
+	_, err := app.LoginWithLoopback(context.Background(), LoopbackLoginOpts{Timeout: 10 * time.Millisecond, OpenURL: noop})
+	assert.Equal(t, ErrLoginTimeout, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = app.LoginWithLoopback(ctx, LoopbackLoginOpts{OpenURL: noop})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestLoginWithLoopbackRedirectURI(t *testing.T) {
+	app, _ := newLoopbackTestApp(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+	l.Close()
+	app.RedirectURI = "http://localhost:" + port + "/callback"
+
+	var page, redirectURI string
+	browser := fakeBrowser("good", func(s string) string { return s }, &page)
+	token, err := app.LoginWithLoopback(context.Background(), LoopbackLoginOpts{
+		OpenURL: func(authURL string) error {
+			u, _ := url.Parse(authURL)
+			redirectURI = u.Query().Get("redirect_uri")
+			base := "http://127.0.0.1:" + port
+			// the stray requests do not abort the login
+			for _, stray := range []string{"/favicon.ico", "/callback"} {
+				resp, err := http.Get(base + stray)
+				if err != nil {
+					return err
+				}
+				resp.Body.Close()
+				if resp.StatusCode != http.StatusNotFound {
+					t.Errorf("stray request %s: status %d", stray, resp.StatusCode)
+				}
+			}
+			return browser(authURL)
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "token", token.AccessToken)
+	// the registered redirect uri is sent unchanged
+	assert.Equal(t, app.RedirectURI, redirectURI)
+}
+
+func TestLoopbackAddr(t *testing.T) {
+	app := NewAuthorizationAPP(AuthorizationAPP{RedirectURI: "http://localhost:8085/callback"})
+	addr, path, redirectURI := app.loopbackAddr(LoopbackLoginOpts{})
+	assert.Equal(t, "localhost:8085", addr)
+	assert.Equal(t, "/callback", path)
+	assert.Equal(t, "http://localhost:8085/callback", redirectURI)
+
+	addr, path, redirectURI = app.loopbackAddr(LoopbackLoginOpts{Addr: "127.0.0.1:9000", Path: "/cb"})
+	assert.Equal(t, "127.0.0.1:9000", addr)
+	assert.Equal(t, "/cb", path)
+	assert.Equal(t, "http://127.0.0.1:9000/cb", redirectURI)
+
+	app = NewAuthorizationAPP(AuthorizationAPP{RedirectURI: "https://example.com:8443/callback"})
+	addr, path, redirectURI = app.loopbackAddr(LoopbackLoginOpts{})
+	assert.Equal(t, "127.0.0.1:0", addr)
+	assert.Equal(t, "/", path)
+	assert.Equal(t, "", redirectURI)
+}
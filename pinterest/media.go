@@ -1,5 +1,7 @@
 package pinterest
 
+import "context"
+
 /*
 	Media API
 */
@@ -33,9 +35,9 @@ func (m Media) String() string {
 
 // MediaUpload represents the media upload info.
 type MediaUpload struct {
-	MediaID   *string `json:"media_id"`
-	MediaType *string `json:"media_type"`
-	Status    *string `json:"status"`
+	MediaID   *string            `json:"media_id"`
+	MediaType *MediaType         `json:"media_type"`
+	Status    *MediaUploadStatus `json:"status"`
 }
 
 func (m MediaUpload) String() string {
@@ -54,24 +56,45 @@ func (m MediaUploadsResponse) String() string {
 
 // ListMediaUploads List media uploads filtered by given parameters.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/media/list
-func (r *MediaResource) ListMediaUploads(args ListOptions) (*MediaUploadsResponse, *APIError) {
+func (r *MediaResource) ListMediaUploads(args ListOptions) (*MediaUploadsResponse, error) {
+	return r.ListMediaUploadsWithContext(context.Background(), args)
+}
+
+// ListMediaUploadsWithContext is the same as ListMediaUploads, but with a context for the request.
+func (r *MediaResource) ListMediaUploadsWithContext(ctx context.Context, args ListOptions) (*MediaUploadsResponse, error) {
 	path := "/media"
 
 	resp := new(MediaUploadsResponse)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// ListMediaUploadsIter Return an iterator walking through all the media uploads, the pages are fetched by the bookmark.
+func (r *MediaResource) ListMediaUploadsIter(args ListOptions) *Iterator[*MediaUpload] {
+	return Paginate(func(ctx context.Context, opts ListOptions) ([]*MediaUpload, *string, error) {
+		resp, err := r.ListMediaUploadsWithContext(ctx, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Items, resp.Bookmark, nil
+	}, args)
+}
+
 // GetMediaUploadDetail Get details for a registered media upload, including its current status.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/media/get
-func (r *MediaResource) GetMediaUploadDetail(mediaID string) (*MediaUpload, *APIError) {
+func (r *MediaResource) GetMediaUploadDetail(mediaID string) (*MediaUpload, error) {
+	return r.GetMediaUploadDetailWithContext(context.Background(), mediaID)
+}
+
+// GetMediaUploadDetailWithContext is the same as GetMediaUploadDetail, but with a context for the request.
+func (r *MediaResource) GetMediaUploadDetailWithContext(ctx context.Context, mediaID string) (*MediaUpload, error) {
 	path := "/media/" + mediaID
 
 	resp := new(MediaUpload)
-	err := r.Cli.DoGet(path, nil, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, nil, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -80,13 +103,21 @@ func (r *MediaResource) GetMediaUploadDetail(mediaID string) (*MediaUpload, *API
 
 // RegisterMediaUploadOpts represents the parameters for register media upload.
 type RegisterMediaUploadOpts struct {
-	MediaType string `json:"media_type"`
+	MediaType MediaType `json:"media_type"`
+}
+
+// Validate Check the parameters before sending the request.
+func (r RegisterMediaUploadOpts) Validate() error {
+	if r.MediaType == "" {
+		return &ValidationError{Field: "media_type", Message: "required"}
+	}
+	return validateEnum("media_type", r.MediaType)
 }
 
 // RegisterMediaUploadResponse The response for register media upload.
 type RegisterMediaUploadResponse struct {
 	MediaID          *string           `json:"media_id"`
-	MediaType        *string           `json:"media_type"`
+	MediaType        *MediaType        `json:"media_type"`
 	UploadURL        *string           `json:"upload_url"`
 	UploadParameters map[string]string `json:"upload_parameters"`
 }
@@ -97,11 +128,19 @@ func (m RegisterMediaUploadResponse) String() string {
 
 // RegisterMediaUpload Register your intent to upload media.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/media/create
-func (r *MediaResource) RegisterMediaUpload(args RegisterMediaUploadOpts) (*RegisterMediaUploadResponse, *APIError) {
+func (r *MediaResource) RegisterMediaUpload(args RegisterMediaUploadOpts) (*RegisterMediaUploadResponse, error) {
+	return r.RegisterMediaUploadWithContext(context.Background(), args)
+}
+
+// RegisterMediaUploadWithContext is the same as RegisterMediaUpload, but with a context for the request.
+func (r *MediaResource) RegisterMediaUploadWithContext(ctx context.Context, args RegisterMediaUploadOpts) (*RegisterMediaUploadResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/media"
 
 	resp := new(RegisterMediaUploadResponse)
-	err := r.Cli.DoPost(path, args, resp)
+	err := r.Cli.DoPostWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
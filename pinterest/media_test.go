@@ -51,7 +51,7 @@ func (bc *BCSuite) TestGetMediaUploadDetail() {
 
 	mediaUpload, _ := bc.Pin.Media.GetMediaUploadDetail(muID)
 	bc.Equal(*mediaUpload.MediaID, "5216391720465385860")
-	bc.Equal(*mediaUpload.MediaType, "video")
+	bc.Equal(*mediaUpload.MediaType, MediaTypeVideo)
 }
 
 func (bc *BCSuite) TestRegisterMediaUpload() {
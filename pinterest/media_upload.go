@@ -0,0 +1,188 @@
+package pinterest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+/*
+	Upload media to the upload url and wait until the media is ready
+	Refer: https://developers.pinterest.com/docs/solutions/content-apps/#uploadingvideo
+*/
+
+// ErrMediaUploadFailed is returned when Pinterest failed to process the uploaded media.
+var ErrMediaUploadFailed = errors.New("pinterest: media upload processing failed")
+
+// UploadMediaOpts represents the options for upload media.
+type UploadMediaOpts struct {
+	// Size the size of the content in bytes. If zero, it is detected when the reader is an io.Seeker
+	// or has a Len method. The upload url requires the content length, so the size should be given
+	// if it can not be detected.
+	Size int64
+	// PollInterval the interval to check the media status, default to 2 seconds.
+	PollInterval time.Duration
+	// Timeout for waiting the media processed, default to 10 minutes.
+	Timeout time.Duration
+	// HTTPClient the client to send the content to the upload url, default to http.DefaultClient.
+	// Note it should not carry the Pinterest authorization.
+	HTTPClient *http.Client
+}
+
+// readerSize Return the remaining size of the reader if it can be detected.
+func readerSize(r io.Reader) (int64, bool) {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len()), true
+	case io.Seeker:
+		cur, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+		end, err := v.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, false
+		}
+		if _, err = v.Seek(cur, io.SeekStart); err != nil {
+			return 0, false
+		}
+		return end - cur, true
+	}
+	return 0, false
+}
+
+// uploadBody Return the multipart form body with the upload parameters and the file content, the content
+// is streamed from the reader. The size is -1 if the content size is unknown.
+func uploadBody(params map[string]string, content io.Reader, size int64, filename string) (io.Reader, string, int64, error) {
+	head := new(bytes.Buffer)
+	w := multipart.NewWriter(head)
+
+	// The file must be the last field of the form.
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := w.WriteField(k, params[k]); err != nil {
+			return nil, "", 0, err
+		}
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(filename))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="file"; filename="`+strings.NewReplacer(`"`, `\"`, `\`, `\\`).Replace(filename)+`"`)
+	h.Set("Content-Type", contentType)
+	if _, err := w.CreatePart(h); err != nil {
+		return nil, "", 0, err
+	}
+
+	tail := new(bytes.Buffer)
+	tail.WriteString("\r\n--" + w.Boundary() + "--\r\n")
+
+	length := int64(-1)
+	if size >= 0 {
+		length = int64(head.Len()) + size + int64(tail.Len())
+	}
+	return io.MultiReader(head, content, tail), w.FormDataContentType(), length, nil
+}
+
+// UploadContent Send the content to the upload url of the registered media upload.
+func (r *MediaResource) UploadContent(ctx context.Context, register *RegisterMediaUploadResponse, content io.Reader, filename string, opts UploadMediaOpts) error {
+	if register.UploadURL == nil {
+		return errors.New("pinterest: no upload url for the media")
+	}
+
+	size := opts.Size
+	if size <= 0 {
+		var ok bool
+		if size, ok = readerSize(content); !ok {
+			size = -1
+		}
+	}
+	body, contentType, length, err := uploadBody(register.UploadParameters, content, size, filename)
+	if err != nil {
+		return &EncodeError{Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, HttpPost, *register.UploadURL, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.ContentLength = length
+
+	hc := opts.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return &TransportError{Method: HttpPost, URL: *register.UploadURL, Err: err}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return &APIError{Code: resp.StatusCode, Message: strings.TrimSpace(string(data)), StatusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+// WaitMediaUpload Poll the media upload status until it succeeded or failed.
+// ErrMediaUploadFailed is returned along with the media upload if it failed.
+func (r *MediaResource) WaitMediaUpload(ctx context.Context, mediaID string, opts UploadMediaOpts) (*MediaUpload, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 2 * time.Second
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Minute
+	}
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	for {
+		upload, err := r.GetMediaUploadDetailWithContext(ctx, mediaID)
+		if err != nil {
+			return nil, err
+		}
+		if upload.Status != nil {
+			switch *upload.Status {
+			case MediaUploadStatusSucceeded:
+				return upload, nil
+			case MediaUploadStatusFailed:
+				return upload, ErrMediaUploadFailed
+			}
+		}
+		if err = sleepContext(ctx, opts.PollInterval); err != nil {
+			return upload, err
+		}
+	}
+}
+
+// Upload Register the media upload, send the content to the upload url, and wait until the media is processed.
+// The content is streamed, so the large videos are not buffered in memory.
+func (r *MediaResource) Upload(ctx context.Context, content io.Reader, filename string, mediaType MediaType, opts UploadMediaOpts) (*MediaUpload, error) {
+	register, err := r.RegisterMediaUploadWithContext(ctx, RegisterMediaUploadOpts{MediaType: mediaType})
+	if err != nil {
+		return nil, err
+	}
+	if register.MediaID == nil {
+		return nil, errors.New("pinterest: no media id for the registered media upload")
+	}
+	if err = r.UploadContent(ctx, register, content, filename, opts); err != nil {
+		return nil, err
+	}
+	return r.WaitMediaUpload(ctx, *register.MediaID, opts)
+}
@@ -0,0 +1,152 @@
+package pinterest
+
+import (
+	"context"
+	"errors"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// uploadServer Return a server acts as the upload url, it records the form of the upload.
+func uploadServer(t *testing.T, form map[string]string, contentLength *int64) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*contentLength = r.ContentLength
+		for k := range form {
+			delete(form, k)
+		}
+		mr, err := r.MultipartReader()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			data, _ := io.ReadAll(part)
+			if part.FormName() == "file" {
+				form["file"] = part.FileName() + ":" + part.Header.Get("Content-Type") + ":" + string(data)
+				continue
+			}
+			if form["file"] != "" {
+				http.Error(w, "file must be the last field", http.StatusBadRequest)
+				return
+			}
+			form[part.FormName()] = string(data)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func (bc *BCSuite) TestUploadMedia() {
+	form := make(map[string]string)
+	var contentLength int64
+	ts := uploadServer(bc.T(), form, &contentLength)
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/media",
+		httpmock.NewStringResponder(
+			201,
+			`{"media_id":"5216393791692388749","media_type":"video","upload_url":"`+ts.URL+`","upload_parameters":{"x-amz-date":"20220220T082536Z","key":"key","policy":"policy","Content-Type":"multipart/form-data"}}`,
+		),
+	)
+	polls := 0
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/media/5216393791692388749",
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			if polls < 2 {
+				return httpmock.NewStringResponse(200, `{"media_id":"5216393791692388749","media_type":"video","status":"processing"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"media_id":"5216393791692388749","media_type":"video","status":"succeeded"}`), nil
+		},
+	)
+
+	upload, err := bc.Pin.Media.Upload(context.Background(), strings.NewReader("video content"), "video.mp4", "video", UploadMediaOpts{PollInterval: time.Millisecond})
+	bc.Nil(err)
+	bc.Equal(MediaUploadStatusSucceeded, *upload.Status)
+	bc.Equal(2, polls)
+	bc.Equal("key", form["key"])
+	bc.Equal("multipart/form-data", form["Content-Type"])
+	bc.Equal("video.mp4:video/mp4:video content", form["file"])
+	bc.True(contentLength > int64(len("video content")))
+
+	// unknown size is sent chunked
+	_, err = bc.Pin.Media.Upload(context.Background(), io.LimitReader(strings.NewReader("video content"), 5), "video", "video", UploadMediaOpts{PollInterval: time.Millisecond})
+	bc.Nil(err)
+	bc.Equal(int64(-1), contentLength)
+	bc.Equal("video:application/octet-stream:video", form["file"])
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/media/5216393791692388749",
+		httpmock.NewStringResponder(200, `{"media_id":"5216393791692388749","media_type":"video","status":"failed"}`),
+	)
+	upload, err = bc.Pin.Media.Upload(context.Background(), strings.NewReader("video content"), "video.mp4", "video", UploadMediaOpts{})
+	bc.True(errors.Is(err, ErrMediaUploadFailed))
+	bc.Equal(MediaUploadStatusFailed, *upload.Status)
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/media/5216393791692388749",
+		httpmock.NewStringResponder(200, `{"media_id":"5216393791692388749","media_type":"video","status":"processing"}`),
+	)
+	_, err = bc.Pin.Media.Upload(context.Background(), strings.NewReader("video content"), "video.mp4", "video", UploadMediaOpts{PollInterval: time.Millisecond, Timeout: 10 * time.Millisecond})
+	bc.True(errors.Is(err, context.DeadlineExceeded))
+}
+
+func (bc *BCSuite) TestUploadMediaFailed() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		http.Error(w, "<Error><Code>AccessDenied</Code></Error>", http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/media",
+		httpmock.NewStringResponder(
+			201,
+			`{"media_id":"5216393791692388749","media_type":"video","upload_url":"`+ts.URL+`","upload_parameters":{"key":"key"}}`,
+		),
+	)
+	_, err := bc.Pin.Media.Upload(context.Background(), strings.NewReader("video content"), "video.mp4", "video", UploadMediaOpts{})
+	var apiErr *APIError
+	bc.True(errors.As(err, &apiErr))
+	bc.Equal(http.StatusForbidden, apiErr.StatusCode)
+	bc.Contains(apiErr.Message, "AccessDenied")
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/media",
+		httpmock.NewStringResponder(401, `{"code":2,"message":"Authentication failed.","status":"failure"}`),
+	)
+	_, err = bc.Pin.Media.Upload(context.Background(), strings.NewReader("video content"), "video.mp4", "video", UploadMediaOpts{})
+	bc.True(errors.Is(err, ErrUnauthorized))
+}
+
+func TestReaderSize(t *testing.T) {
+	size, ok := readerSize(strings.NewReader("abc"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), size)
+
+	r := io.NewSectionReader(strings.NewReader("abcdef"), 0, 6)
+	r.Seek(2, io.SeekStart)
+	size, ok = readerSize(r)
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), size)
+	pos, _ := r.Seek(0, io.SeekCurrent)
+	assert.Equal(t, int64(2), pos)
+
+	_, ok = readerSize(io.LimitReader(strings.NewReader("abc"), 1))
+	assert.False(t, ok)
+}
@@ -0,0 +1,16 @@
+package pinterest
+
+import "math"
+
+// MicroCurrency represents an amount of money in micro units of the ad account currency, 1 unit is 1,000,000 micros.
+type MicroCurrency int64
+
+// Micros Return the micro currency for the amount in currency units, rounded to the nearest micro.
+func Micros(amount float64) MicroCurrency {
+	return MicroCurrency(math.Round(amount * 1e6))
+}
+
+// Amount Return the amount in currency units.
+func (m MicroCurrency) Amount() float64 {
+	return float64(m) / 1e6
+}
@@ -0,0 +1,12 @@
+package pinterest
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMicroCurrency(t *testing.T) {
+	assert.Equal(t, MicroCurrency(1500000), Micros(1.5))
+	assert.Equal(t, MicroCurrency(100000), Micros(0.1))
+	assert.Equal(t, 12.345678, MicroCurrency(12345678).Amount())
+}
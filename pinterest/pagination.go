@@ -0,0 +1,111 @@
+package pinterest
+
+import "context"
+
+/*
+	Pagination for the list endpoints paginated by bookmark
+*/
+
+// PageFetcher fetches a page of items with the list options, it returns the items and the bookmark for the next page.
+type PageFetcher[T any] func(ctx context.Context, opts ListOptions) ([]T, *string, error)
+
+// Iterator walks through the items of a bookmark paginated list endpoint, fetching the pages as needed.
+//
+//	it := client.Board.ListBoardsIter(pinterest.ListBoardOpts{})
+//	for it.Next(ctx) {
+//		board := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type Iterator[T any] struct {
+	fetch    PageFetcher[T]
+	opts     ListOptions
+	maxItems int
+
+	page  []T
+	item  T
+	count int
+	done  bool
+	err   error
+}
+
+// Paginate Return an iterator for the fetcher, starting from the bookmark and with the page size in opts.
+func Paginate[T any](fetch PageFetcher[T], opts ListOptions) *Iterator[T] {
+	return &Iterator[T]{fetch: fetch, opts: opts}
+}
+
+// SetMaxItems Stop the iterator after max items, zero means no limit.
+func (it *Iterator[T]) SetMaxItems(max int) *Iterator[T] {
+	it.maxItems = max
+	return it
+}
+
+// SetPageSize Set the page size hint for the requests, zero means the api default.
+func (it *Iterator[T]) SetPageSize(size int) *Iterator[T] {
+	it.opts.PageSize = size
+	return it
+}
+
+// Next Advance the iterator to the next item, it returns false when there are no more items,
+// the max items is reached, or an error occurs. Check Err after Next returns false.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for {
+		if it.err != nil || (it.maxItems > 0 && it.count >= it.maxItems) {
+			return false
+		}
+		if len(it.page) > 0 {
+			it.item = it.page[0]
+			it.page = it.page[1:]
+			it.count++
+			return true
+		}
+		if it.done {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		opts := it.opts
+		if remaining := it.maxItems - it.count; it.maxItems > 0 && opts.PageSize > remaining {
+			opts.PageSize = remaining
+		}
+		items, bookmark, err := it.fetch(ctx, opts)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = items
+		if bookmark == nil || *bookmark == "" || *bookmark == it.opts.Bookmark {
+			it.done = true
+		} else {
+			it.opts.Bookmark = *bookmark
+		}
+	}
+}
+
+// Item Return the current item.
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err Return the error stopped the iterator.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Bookmark Return the bookmark for the next page, it can be used to resume the listing later.
+func (it *Iterator[T]) Bookmark() string {
+	return it.opts.Bookmark
+}
+
+// All Return all the remaining items, the collected items are returned along with the error.
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for it.Next(ctx) {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
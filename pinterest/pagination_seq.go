@@ -0,0 +1,30 @@
+//go:build go1.23
+
+package pinterest
+
+import (
+	"context"
+	"iter"
+)
+
+// Seq Return the remaining items as an iter.Seq2, for use with range.
+// The error stopped the iterator is yielded as the last pair.
+//
+//	for board, err := range client.Board.ListBoardsIter(pinterest.ListBoardOpts{}).Seq(ctx) {
+//		if err != nil {
+//			// handle error
+//		}
+//	}
+func (it *Iterator[T]) Seq(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next(ctx) {
+			if !yield(it.Item(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
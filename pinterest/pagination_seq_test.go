@@ -0,0 +1,28 @@
+//go:build go1.23
+
+package pinterest
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIteratorSeq(t *testing.T) {
+	var calls []ListOptions
+	var items []int
+	for item, err := range Paginate(pagesFetcher([][]int{{1, 2}, {3}}, &calls), ListOptions{}).Seq(context.Background()) {
+		assert.Nil(t, err)
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{1, 2, 3}, items)
+
+	fetchErr := errors.New("fetch failed")
+	it := Paginate(func(ctx context.Context, opts ListOptions) ([]int, *string, error) {
+		return nil, nil, fetchErr
+	}, ListOptions{})
+	for _, err := range it.Seq(context.Background()) {
+		assert.Equal(t, fetchErr, err)
+	}
+}
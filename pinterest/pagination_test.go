@@ -0,0 +1,94 @@
+package pinterest
+
+import (
+	"context"
+	"errors"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// pagesFetcher Return a fetcher serving the pages, the bookmark is the index of the next page.
+func pagesFetcher(pages [][]int, calls *[]ListOptions) PageFetcher[int] {
+	return func(ctx context.Context, opts ListOptions) ([]int, *string, error) {
+		*calls = append(*calls, opts)
+		idx := 0
+		if opts.Bookmark != "" {
+			idx, _ = strconv.Atoi(opts.Bookmark)
+		}
+		if idx+1 < len(pages) {
+			return pages[idx], String(strconv.Itoa(idx + 1)), nil
+		}
+		return pages[idx], nil, nil
+	}
+}
+
+func TestIterator(t *testing.T) {
+	var calls []ListOptions
+	it := Paginate(pagesFetcher([][]int{{1, 2}, {}, {3}}, &calls), ListOptions{PageSize: 2})
+	items, err := it.All(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Equal(t, []ListOptions{{PageSize: 2}, {Bookmark: "1", PageSize: 2}, {Bookmark: "2", PageSize: 2}}, calls)
+	assert.False(t, it.Next(context.Background()))
+}
+
+func TestIteratorMaxItems(t *testing.T) {
+	var calls []ListOptions
+	it := Paginate(pagesFetcher([][]int{{1, 2, 3}, {4, 5, 6}, {7}}, &calls), ListOptions{}).SetPageSize(3).SetMaxItems(5)
+	items, err := it.All(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	assert.Equal(t, 2, calls[1].PageSize)
+	assert.Equal(t, "2", it.Bookmark())
+}
+
+func TestIteratorError(t *testing.T) {
+	fetchErr := errors.New("fetch failed")
+	pages := 0
+	it := Paginate(func(ctx context.Context, opts ListOptions) ([]int, *string, error) {
+		pages++
+		if pages > 1 {
+			return nil, nil, fetchErr
+		}
+		return []int{1}, String("next"), nil
+	}, ListOptions{})
+	items, err := it.All(context.Background())
+	assert.Equal(t, []int{1}, items)
+	assert.Equal(t, fetchErr, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it = Paginate(func(ctx context.Context, opts ListOptions) ([]int, *string, error) {
+		return []int{1}, nil, nil
+	}, ListOptions{})
+	assert.False(t, it.Next(ctx))
+	assert.Equal(t, context.Canceled, it.Err())
+}
+
+func (bc *BCSuite) TestListBoardsIter() {
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/boards",
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("bookmark") == "" {
+				return httpmock.NewStringResponse(200, `{"items":[{"name":"City","id":"1022106146619699845"}],"bookmark":"b1"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"items":[{"name":"Food","id":"1022106146619703648"}],"bookmark":null}`), nil
+		},
+	)
+
+	boards, err := bc.Pin.Board.ListBoardsIter(ListBoardOpts{Privacy: "PUBLIC"}).All(context.Background())
+	bc.Nil(err)
+	bc.Len(boards, 2)
+	bc.Equal(*boards[1].Name, "Food")
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/123/campaigns",
+		httpmock.NewStringResponder(403, `{"code":29,"message":"You are not permitted to access that resource."}`),
+	)
+	it := bc.Pin.AdAccount.ListCampaignsIter("123", ListCampaignsOpts{})
+	bc.False(it.Next(context.Background()))
+	bc.True(errors.Is(it.Err(), ErrForbiddenScope))
+}
@@ -1,5 +1,10 @@
 package pinterest
 
+import (
+	"context"
+	"encoding/json"
+)
+
 /*
 	Pin API
 */
@@ -12,16 +17,22 @@ func newPinResource(cli *Client) *PinResource {
 
 // Pin represents the pin info.
 type Pin struct {
-	ID             *string     `json:"id"`
-	CreatedAt      *string     `json:"created_at"`
-	Link           *string     `json:"link"`
-	Title          *string     `json:"title"`
-	Description    *string     `json:"description"`
-	AltText        *string     `json:"alt_text"`
-	BoardID        *string     `json:"board_id"`
-	BoardSectionID *string     `json:"board_section_id"`
-	BoardOwner     *BoardOwner `json:"board_owner"`
-	Media          *Media      `json:"media"`
+	ID             *string       `json:"id"`
+	CreatedAt      *Timestamp    `json:"created_at"`
+	Link           *string       `json:"link"`
+	Title          *string       `json:"title"`
+	Description    *string       `json:"description"`
+	AltText        *string       `json:"alt_text"`
+	BoardID        *string       `json:"board_id"`
+	BoardSectionID *string       `json:"board_section_id"`
+	BoardOwner     *BoardOwner   `json:"board_owner"`
+	Media          *Media        `json:"media"`
+	DominantColor  *string       `json:"dominant_color"`
+	ParentPinID    *string       `json:"parent_pin_id"`
+	Note           *string       `json:"note"`
+	CreativeType   *CreativeType `json:"creative_type"`
+	IsOwner        *bool         `json:"is_owner"`
+	IsStandard     *bool         `json:"is_standard"`
 }
 
 func (p Pin) String() string {
@@ -38,14 +49,19 @@ func (p PinsResponse) String() string {
 	return Stringify(p)
 }
 
-// CreatePinMediaSourceOpts represents the parameters for pin media resource
+// CreatePinMediaSourceOpts represents the parameters for pin media resource.
+// Use the builders like NewImageURLSource, NewVideoSource or NewMultipleImageURLsSource to make a valid one.
 type CreatePinMediaSourceOpts struct {
-	SourceType    string `json:"source_type"`
-	ContentType   string `json:"content_type,omitempty"`
-	Data          string `json:"data,omitempty,omitempty"`
-	Url           string `json:"url,omitempty"`
-	CoverImageURL string `json:"cover_image_url,omitempty"`
-	MediaID       string `json:"media_id,omitempty"`
+	SourceType             PinSourceType       `json:"source_type"`
+	ContentType            string              `json:"content_type,omitempty"`
+	Data                   string              `json:"data,omitempty,omitempty"`
+	Url                    string              `json:"url,omitempty"`
+	CoverImageURL          string              `json:"cover_image_url,omitempty"`
+	CoverImageKeyFrameTime *float64            `json:"cover_image_key_frame_time,omitempty"`
+	MediaID                string              `json:"media_id,omitempty"`
+	Items                  []*CarouselItemOpts `json:"items,omitempty"`
+	Index                  *int                `json:"index,omitempty"`
+	IsAffiliateLink        *bool               `json:"is_affiliate_link,omitempty"`
 }
 
 // CreatePinOpts represents the parameters for create a pin
@@ -61,11 +77,19 @@ type CreatePinOpts struct {
 
 // CreatePin Create a Pin on a board or board section owned by the "operation user_account".
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/pins/get
-func (r *PinResource) CreatePin(args CreatePinOpts) (*Pin, *APIError) {
+func (r *PinResource) CreatePin(args CreatePinOpts) (*Pin, error) {
+	return r.CreatePinWithContext(context.Background(), args)
+}
+
+// CreatePinWithContext is the same as CreatePin, but with a context for the request.
+func (r *PinResource) CreatePinWithContext(ctx context.Context, args CreatePinOpts) (*Pin, error) {
+	if err := args.MediaSource.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/pins"
 
 	resp := new(Pin)
-	err := r.Cli.DoPost(path, args, resp)
+	err := r.Cli.DoPostWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -79,16 +103,21 @@ type getPinOpts struct {
 
 // GetPin Get a Pin owned by the "operation user_account" - or on a group board that has been shared with this account.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/pins/get
-func (r *PinResource) GetPin(pinID, adAccountID string) (*Pin, *APIError) {
+func (r *PinResource) GetPin(pinID, adAccountID string) (*Pin, error) {
+	return r.GetPinWithContext(context.Background(), pinID, adAccountID)
+}
+
+// GetPinWithContext is the same as GetPin, but with a context for the request.
+func (r *PinResource) GetPinWithContext(ctx context.Context, pinID, adAccountID string) (*Pin, error) {
 	path := "/pins/" + pinID
 
 	resp := new(Pin)
-	var err *APIError
+	var err error
 	if adAccountID != "" {
 		params := getPinOpts{AdAccountID: adAccountID}
-		err = r.Cli.DoGet(path, params, resp)
+		err = r.Cli.DoGetWithContext(ctx, path, params, resp)
 	} else {
-		err = r.Cli.DoGet(path, nil, resp)
+		err = r.Cli.DoGetWithContext(ctx, path, nil, resp)
 	}
 	if err != nil {
 		return nil, err
@@ -96,11 +125,122 @@ func (r *PinResource) GetPin(pinID, adAccountID string) (*Pin, *APIError) {
 	return resp, nil
 }
 
+// UpdatePinOpts represents the parameters for update a pin.
+// A nil field is not changed. The fields except BoardID can be cleared by setting them to empty string by String(""),
+// a pin can not be removed from its board.
+type UpdatePinOpts struct {
+	Title          *string `json:"title,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Link           *string `json:"link,omitempty"`
+	AltText        *string `json:"alt_text,omitempty"`
+	Note           *string `json:"note,omitempty"`
+	BoardID        *string `json:"board_id,omitempty"`
+	BoardSectionID *string `json:"board_section_id,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (u UpdatePinOpts) Validate() error {
+	if u.BoardID != nil && *u.BoardID == "" {
+		return &ValidationError{Field: "board_id", Message: "can not be cleared"}
+	}
+	return nil
+}
+
+// MarshalJSON Encode the opts, the fields set to empty string are sent as null to clear them.
+func (u UpdatePinOpts) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]*string)
+	for name, v := range map[string]*string{
+		"title":            u.Title,
+		"description":      u.Description,
+		"link":             u.Link,
+		"alt_text":         u.AltText,
+		"note":             u.Note,
+		"board_section_id": u.BoardSectionID,
+	} {
+		switch {
+		case v == nil:
+		case *v == "":
+			fields[name] = nil
+		default:
+			fields[name] = v
+		}
+	}
+	if u.BoardID != nil {
+		fields["board_id"] = u.BoardID
+	}
+	return json.Marshal(fields)
+}
+
+// UpdatePin Update a pin owned by the "operation user_account".
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/pins/update
+func (r *PinResource) UpdatePin(pinID string, args UpdatePinOpts) (*Pin, error) {
+	return r.UpdatePinWithContext(context.Background(), pinID, args)
+}
+
+// UpdatePinWithContext is the same as UpdatePin, but with a context for the request.
+func (r *PinResource) UpdatePinWithContext(ctx context.Context, pinID string, args UpdatePinOpts) (*Pin, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/pins/" + pinID
+
+	resp := new(Pin)
+	err := r.Cli.DoPatchWithContext(ctx, path, args, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SavePinOpts represents the parameters for save a pin.
+type SavePinOpts struct {
+	BoardID        string `json:"board_id,omitempty"`
+	BoardSectionID string `json:"board_section_id,omitempty"`
+}
+
+// SavePin Save a pin to a board or board section owned by the "operation user_account".
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/pins/save
+func (r *PinResource) SavePin(pinID string, args SavePinOpts) (*Pin, error) {
+	return r.SavePinWithContext(context.Background(), pinID, args)
+}
+
+// SavePinWithContext is the same as SavePin, but with a context for the request.
+func (r *PinResource) SavePinWithContext(ctx context.Context, pinID string, args SavePinOpts) (*Pin, error) {
+	path := "/pins/" + pinID + "/save"
+
+	resp := new(Pin)
+	err := r.Cli.DoPostWithContext(ctx, path, args, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// MovePin Move a pin to the board and section. The pin stays on its current board if boardID is empty,
+// and it is removed from its current section if sectionID is empty.
+func (r *PinResource) MovePin(pinID, boardID, sectionID string) (*Pin, error) {
+	return r.MovePinWithContext(context.Background(), pinID, boardID, sectionID)
+}
+
+// MovePinWithContext is the same as MovePin, but with a context for the request.
+func (r *PinResource) MovePinWithContext(ctx context.Context, pinID, boardID, sectionID string) (*Pin, error) {
+	args := UpdatePinOpts{BoardSectionID: String(sectionID)}
+	if boardID != "" {
+		args.BoardID = String(boardID)
+	}
+	return r.UpdatePinWithContext(ctx, pinID, args)
+}
+
 // DeletePin Delete a Pins owned by the "operation user_account" - or on a group board that has been shared with this account.
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/pins/delete
-func (r *PinResource) DeletePin(pinID string) *APIError {
+func (r *PinResource) DeletePin(pinID string) error {
+	return r.DeletePinWithContext(context.Background(), pinID)
+}
+
+// DeletePinWithContext is the same as DeletePin, but with a context for the request.
+func (r *PinResource) DeletePinWithContext(ctx context.Context, pinID string) error {
 	path := "/pins/" + pinID
-	err := r.Cli.DoDelete(path, nil)
+	err := r.Cli.DoDeleteWithContext(ctx, path, nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,198 @@
+package pinterest
+
+import (
+	"context"
+	"fmt"
+)
+
+/*
+	Pin Analytics API
+	Refer: https://developers.pinterest.com/docs/api/v5/#operation/pins/analytics
+*/
+
+// MetricType represents the metric type for the analytics.
+type MetricType string
+
+const (
+	MetricImpression                  MetricType = "IMPRESSION"
+	MetricOutboundClick               MetricType = "OUTBOUND_CLICK"
+	MetricPinClick                    MetricType = "PIN_CLICK"
+	MetricSave                        MetricType = "SAVE"
+	MetricSaveRate                    MetricType = "SAVE_RATE"
+	MetricTotalComments               MetricType = "TOTAL_COMMENTS"
+	MetricTotalReactions              MetricType = "TOTAL_REACTIONS"
+	MetricUserFollow                  MetricType = "USER_FOLLOW"
+	MetricProfileVisit                MetricType = "PROFILE_VISIT"
+	MetricVideoMRCView                MetricType = "VIDEO_MRC_VIEW"
+	MetricVideoStart                  MetricType = "VIDEO_START"
+	MetricVideoAvgWatchTime           MetricType = "VIDEO_AVG_WATCH_TIME"
+	MetricVideoV50WatchTime           MetricType = "VIDEO_V50_WATCH_TIME"
+	MetricQuartile95PercentView       MetricType = "QUARTILE_95_PERCENT_VIEW"
+	MetricVideo10SView                MetricType = "VIDEO_10S_VIEW"
+	MetricFullScreenPlay              MetricType = "FULL_SCREEN_PLAY"
+	MetricFullScreenPlaytime          MetricType = "FULL_SCREEN_PLAYTIME"
+	MetricTotalIdeaPinProductTagVisit MetricType = "TOTAL_IDEA_PIN_PRODUCT_TAG_VISIT"
+)
+
+func (m MetricType) IsValid() bool {
+	switch m {
+	case MetricImpression, MetricOutboundClick, MetricPinClick, MetricSave, MetricSaveRate, MetricTotalComments,
+		MetricTotalReactions, MetricUserFollow, MetricProfileVisit, MetricVideoMRCView, MetricVideoStart,
+		MetricVideoAvgWatchTime, MetricVideoV50WatchTime, MetricQuartile95PercentView, MetricVideo10SView,
+		MetricFullScreenPlay, MetricFullScreenPlaytime, MetricTotalIdeaPinProductTagVisit:
+		return true
+	}
+	return false
+}
+
+// AnalyticsAppType represents the app types to get the pin analytics for.
+type AnalyticsAppType string
+
+const (
+	AnalyticsAppTypeAll    AnalyticsAppType = "ALL"
+	AnalyticsAppTypeMobile AnalyticsAppType = "MOBILE"
+	AnalyticsAppTypeTablet AnalyticsAppType = "TABLET"
+	AnalyticsAppTypeWeb    AnalyticsAppType = "WEB"
+)
+
+func (a AnalyticsAppType) IsValid() bool {
+	switch a {
+	case AnalyticsAppTypeAll, AnalyticsAppTypeMobile, AnalyticsAppTypeTablet, AnalyticsAppTypeWeb:
+		return true
+	}
+	return false
+}
+
+// AnalyticsSplitField represents the field to split the pin analytics by.
+type AnalyticsSplitField string
+
+const (
+	AnalyticsSplitNone    AnalyticsSplitField = "NO_SPLIT"
+	AnalyticsSplitAppType AnalyticsSplitField = "APP_TYPE"
+)
+
+func (a AnalyticsSplitField) IsValid() bool {
+	switch a {
+	case AnalyticsSplitNone, AnalyticsSplitAppType:
+		return true
+	}
+	return false
+}
+
+// MultiPinAnalyticsMaxPins is the max number of pins for the multiple pins analytics.
+const MultiPinAnalyticsMaxPins = 100
+
+// validatePinAnalytics Check the metric types and the app types of the pin analytics parameters.
+func validatePinAnalytics(metricTypes []MetricType, appTypes AnalyticsAppType) error {
+	if len(metricTypes) == 0 {
+		return &ValidationError{Field: "metric_types", Message: "required"}
+	}
+	if err := validateEnums("metric_types", metricTypes); err != nil {
+		return err
+	}
+	return validateEnum("app_types", appTypes)
+}
+
+// PinAnalyticsMetrics represents the metrics info of a pin for days.
+type PinAnalyticsMetrics struct {
+	DailyMetrics    []*DailyMetrics `json:"daily_metrics"`
+	SummaryMetrics  *Metrics        `json:"summary_metrics"`
+	LifetimeMetrics *Metrics        `json:"lifetime_metrics"`
+}
+
+func (m PinAnalyticsMetrics) String() string {
+	return Stringify(m)
+}
+
+// PinAnalytics represents the response for the pin analytics.
+// The metrics are keyed by "all", and by the values of the split field if it is given.
+type PinAnalytics map[string]*PinAnalyticsMetrics
+
+// All Return the metrics of all the app types.
+func (p PinAnalytics) All() *PinAnalyticsMetrics {
+	return p["all"]
+}
+
+// MultiPinAnalytics represents the response for the multiple pins analytics, keyed by the pin id.
+type MultiPinAnalytics map[string]PinAnalytics
+
+// GetPinAnalyticsOpts represents the parameters for get pin analytics.
+type GetPinAnalyticsOpts struct {
+	StartDate   string              `url:"start_date"`
+	EndDate     string              `url:"end_date"`
+	MetricTypes []MetricType        `url:"metric_types,comma"`
+	AppTypes    AnalyticsAppType    `url:"app_types,omitempty"`
+	SplitField  AnalyticsSplitField `url:"split_field,omitempty"`
+	AdAccountID string              `url:"ad_account_id,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (g GetPinAnalyticsOpts) Validate() error {
+	if err := validatePinAnalytics(g.MetricTypes, g.AppTypes); err != nil {
+		return err
+	}
+	return validateEnum("split_field", g.SplitField)
+}
+
+// GetPinAnalytics Get analytics for a pin owned by the "operation user_account" - or on a group board that has been shared with this account.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/pins/analytics
+func (r *PinResource) GetPinAnalytics(pinID string, args GetPinAnalyticsOpts) (PinAnalytics, error) {
+	return r.GetPinAnalyticsWithContext(context.Background(), pinID, args)
+}
+
+// GetPinAnalyticsWithContext is the same as GetPinAnalytics, but with a context for the request.
+func (r *PinResource) GetPinAnalyticsWithContext(ctx context.Context, pinID string, args GetPinAnalyticsOpts) (PinAnalytics, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/pins/" + pinID + "/analytics"
+
+	var resp PinAnalytics
+	err := r.Cli.DoGetWithContext(ctx, path, args, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetMultiPinAnalyticsOpts represents the parameters for get multiple pins analytics.
+type GetMultiPinAnalyticsOpts struct {
+	PinIDs      []string         `url:"pin_ids,comma"`
+	StartDate   string           `url:"start_date"`
+	EndDate     string           `url:"end_date"`
+	MetricTypes []MetricType     `url:"metric_types,comma"`
+	AppTypes    AnalyticsAppType `url:"app_types,omitempty"`
+	AdAccountID string           `url:"ad_account_id,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (g GetMultiPinAnalyticsOpts) Validate() error {
+	if len(g.PinIDs) == 0 {
+		return &ValidationError{Field: "pin_ids", Message: "required"}
+	}
+	if len(g.PinIDs) > MultiPinAnalyticsMaxPins {
+		return &ValidationError{Field: "pin_ids", Message: fmt.Sprintf("at most %d pins", MultiPinAnalyticsMaxPins)}
+	}
+	return validatePinAnalytics(g.MetricTypes, g.AppTypes)
+}
+
+// GetMultiPinAnalytics Get analytics for multiple pins owned by the "operation user_account" - or on a group board that has been shared with this account.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/multi_pins/analytics
+func (r *PinResource) GetMultiPinAnalytics(args GetMultiPinAnalyticsOpts) (MultiPinAnalytics, error) {
+	return r.GetMultiPinAnalyticsWithContext(context.Background(), args)
+}
+
+// GetMultiPinAnalyticsWithContext is the same as GetMultiPinAnalytics, but with a context for the request.
+func (r *PinResource) GetMultiPinAnalyticsWithContext(ctx context.Context, args GetMultiPinAnalyticsOpts) (MultiPinAnalytics, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/pins/analytics"
+
+	var resp MultiPinAnalytics
+	err := r.Cli.DoGetWithContext(ctx, path, args, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package pinterest
+
+import (
+	"github.com/jarcoal/httpmock"
+	"net/http"
+	"net/url"
+)
+
+func (bc *BCSuite) TestGetPinAnalytics() {
+	pinID := "1022106077902810180"
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/pins/"+pinID+"/analytics",
+		httpmock.NewStringResponder(
+			400,
+			`{"code":1,"message":"Parameter 'metric_types' is required."}`,
+		),
+	)
+	_, err := bc.Pin.Pin.GetPinAnalytics(pinID, GetPinAnalyticsOpts{})
+	bc.Equal(&ValidationError{Field: "metric_types", Message: "required"}, err)
+
+	_, err = bc.Pin.Pin.GetPinAnalytics(pinID, GetPinAnalyticsOpts{MetricTypes: []MetricType{MetricSave}, SplitField: "SOURCE"})
+	bc.Equal("split_field", err.(*ValidationError).Field)
+
+	_, err = bc.Pin.Pin.GetPinAnalytics(pinID, GetPinAnalyticsOpts{MetricTypes: []MetricType{MetricSave}})
+	bc.IsType(&APIError{}, err)
+
+	var query url.Values
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/pins/"+pinID+"/analytics",
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(
+				200,
+				`{"all":{"lifetime_metrics":{"IMPRESSION":10,"SAVE":2},"daily_metrics":[{"date":"2022-02-10","data_status":"READY","metrics":{"IMPRESSION":3,"SAVE":1,"PIN_CLICK":1}}],"summary_metrics":{"IMPRESSION":3,"SAVE":1,"PIN_CLICK":1}}}`,
+			), nil
+		},
+	)
+
+	analytics, _ := bc.Pin.Pin.GetPinAnalytics(pinID, GetPinAnalyticsOpts{
+		StartDate:   "2022-02-10",
+		EndDate:     "2022-02-10",
+		MetricTypes: []MetricType{MetricImpression, MetricSave, MetricPinClick},
+		AppTypes:    AnalyticsAppTypeMobile,
+		SplitField:  AnalyticsSplitNone,
+	})
+	bc.Equal("IMPRESSION,SAVE,PIN_CLICK", query.Get("metric_types"))
+	bc.Equal("MOBILE", query.Get("app_types"))
+	bc.Equal("NO_SPLIT", query.Get("split_field"))
+	bc.Equal(*analytics.All().SummaryMetrics.Impression, int64(3))
+	bc.Equal(*analytics.All().LifetimeMetrics.Save, int64(2))
+	bc.Equal(*analytics.All().DailyMetrics[0].Metrics.PinClick, int64(1))
+}
+
+func (bc *BCSuite) TestGetMultiPinAnalytics() {
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/pins/analytics",
+		httpmock.NewStringResponder(
+			400,
+			`{"code":1,"message":"Parameter 'pin_ids' is required."}`,
+		),
+	)
+	_, err := bc.Pin.Pin.GetMultiPinAnalytics(GetMultiPinAnalyticsOpts{MetricTypes: []MetricType{MetricImpression}})
+	bc.Equal(&ValidationError{Field: "pin_ids", Message: "required"}, err)
+
+	_, err = bc.Pin.Pin.GetMultiPinAnalytics(GetMultiPinAnalyticsOpts{PinIDs: make([]string, 101), MetricTypes: []MetricType{MetricImpression}})
+	bc.Equal(&ValidationError{Field: "pin_ids", Message: "at most 100 pins"}, err)
+
+	_, err = bc.Pin.Pin.GetMultiPinAnalytics(GetMultiPinAnalyticsOpts{PinIDs: []string{"1022106077902810180"}})
+	bc.Equal(&ValidationError{Field: "metric_types", Message: "required"}, err)
+
+	_, err = bc.Pin.Pin.GetMultiPinAnalytics(GetMultiPinAnalyticsOpts{PinIDs: []string{"1022106077902810180"}, MetricTypes: []MetricType{MetricImpression}})
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/pins/analytics",
+		httpmock.NewStringResponder(
+			200,
+			`{"1022106077902810180":{"all":{"daily_metrics":[{"date":"2022-02-10","data_status":"READY","metrics":{"IMPRESSION":3}}],"summary_metrics":{"IMPRESSION":3}}},"1022106077902810181":{"all":{"daily_metrics":[],"summary_metrics":{"IMPRESSION":0}}}}`,
+		),
+	)
+
+	analytics, _ := bc.Pin.Pin.GetMultiPinAnalytics(GetMultiPinAnalyticsOpts{
+		PinIDs:      []string{"1022106077902810180", "1022106077902810181"},
+		StartDate:   "2022-02-10",
+		EndDate:     "2022-02-10",
+		MetricTypes: []MetricType{MetricImpression},
+	})
+	bc.Len(analytics, 2)
+	bc.Equal(*analytics["1022106077902810180"].All().SummaryMetrics.Impression, int64(3))
+	bc.Equal(*analytics["1022106077902810181"].All().SummaryMetrics.Impression, int64(0))
+}
@@ -0,0 +1,141 @@
+package pinterest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+)
+
+/*
+	Image helpers for the base64 media source
+*/
+
+// ImageLimits represents the limits checked before the image is sent, a zero limit is not checked.
+type ImageLimits struct {
+	MaxBytes  int64
+	MinWidth  int
+	MinHeight int
+	MaxWidth  int
+	MaxHeight int
+}
+
+// DefaultImageLimits Return the limits for the pin images, Pinterest accepts the images up to 20MB,
+// from 100x100 to 10000x10000 pixels.
+func DefaultImageLimits() ImageLimits {
+	return ImageLimits{
+		MaxBytes:  20 << 20,
+		MinWidth:  100,
+		MinHeight: 100,
+		MaxWidth:  10000,
+		MaxHeight: 10000,
+	}
+}
+
+// ImageInfo represents a checked image.
+type ImageInfo struct {
+	ContentType string
+	Width       int
+	Height      int
+	Data        []byte
+}
+
+// Base64 Return the image data encoded by base64.
+func (i *ImageInfo) Base64() string {
+	return base64.StdEncoding.EncodeToString(i.Data)
+}
+
+var (
+	jpegMagic = []byte{0xFF, 0xD8, 0xFF}
+	pngMagic  = []byte("\x89PNG\r\n\x1a\n")
+)
+
+// sniffImageType Return the content type of the image data by the magic bytes, empty if it is not jpeg or png.
+func sniffImageType(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, jpegMagic):
+		return "image/jpeg"
+	case bytes.HasPrefix(data, pngMagic):
+		return "image/png"
+	}
+	return ""
+}
+
+// ReadImage Read the image, check it is a jpeg or png image within the limits.
+func (l ImageLimits) ReadImage(r io.Reader) (*ImageInfo, error) {
+	if l.MaxBytes > 0 {
+		r = io.LimitReader(r, l.MaxBytes+1)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if l.MaxBytes > 0 && int64(len(data)) > l.MaxBytes {
+		return nil, &ValidationError{Field: "image", Message: fmt.Sprintf("larger than %d bytes", l.MaxBytes)}
+	}
+
+	info := &ImageInfo{ContentType: sniffImageType(data), Data: data}
+	var config image.Config
+	switch info.ContentType {
+	case "image/jpeg":
+		config, err = jpeg.DecodeConfig(bytes.NewReader(data))
+	case "image/png":
+		config, err = png.DecodeConfig(bytes.NewReader(data))
+	default:
+		return nil, &ValidationError{Field: "image", Message: "must be a jpeg or png image"}
+	}
+	if err != nil {
+		return nil, &ValidationError{Field: "image", Message: "broken " + info.ContentType + ": " + err.Error()}
+	}
+	info.Width, info.Height = config.Width, config.Height
+
+	if (l.MinWidth > 0 && info.Width < l.MinWidth) || (l.MinHeight > 0 && info.Height < l.MinHeight) {
+		return nil, &ValidationError{Field: "image", Message: fmt.Sprintf("%dx%d is smaller than %dx%d", info.Width, info.Height, l.MinWidth, l.MinHeight)}
+	}
+	if (l.MaxWidth > 0 && info.Width > l.MaxWidth) || (l.MaxHeight > 0 && info.Height > l.MaxHeight) {
+		return nil, &ValidationError{Field: "image", Message: fmt.Sprintf("%dx%d is larger than %dx%d", info.Width, info.Height, l.MaxWidth, l.MaxHeight)}
+	}
+	return info, nil
+}
+
+// ReadImageFile Read the image file, check it like ReadImage.
+func (l ImageLimits) ReadImageFile(name string) (*ImageInfo, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return l.ReadImage(f)
+}
+
+// NewImageBase64SourceFromReader Return the base64 media source for the jpeg or png image, checked by DefaultImageLimits().
+func NewImageBase64SourceFromReader(r io.Reader) (CreatePinMediaSourceOpts, error) {
+	info, err := DefaultImageLimits().ReadImage(r)
+	if err != nil {
+		return CreatePinMediaSourceOpts{}, err
+	}
+	return NewImageBase64Source(info.ContentType, info.Base64()), nil
+}
+
+// NewImageBase64SourceFromFile Return the base64 media source for the jpeg or png image file, checked by DefaultImageLimits().
+func NewImageBase64SourceFromFile(name string) (CreatePinMediaSourceOpts, error) {
+	info, err := DefaultImageLimits().ReadImageFile(name)
+	if err != nil {
+		return CreatePinMediaSourceOpts{}, err
+	}
+	return NewImageBase64Source(info.ContentType, info.Base64()), nil
+}
+
+// NewCarouselItemFromReader Return the carousel item for the jpeg or png image, checked by DefaultImageLimits().
+// Use it with NewMultipleImageBase64Source.
+func NewCarouselItemFromReader(r io.Reader) (*CarouselItemOpts, error) {
+	info, err := DefaultImageLimits().ReadImage(r)
+	if err != nil {
+		return nil, err
+	}
+	return &CarouselItemOpts{ContentType: info.ContentType, Data: info.Base64()}, nil
+}
@@ -0,0 +1,94 @@
+package pinterest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testImage(t *testing.T, format string, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	buf := new(bytes.Buffer)
+	var err error
+	if format == "png" {
+		err = png.Encode(buf, img)
+	} else {
+		err = jpeg.Encode(buf, img, nil)
+	}
+	assert.Nil(t, err)
+	return buf.Bytes()
+}
+
+func TestReadImage(t *testing.T) {
+	data := testImage(t, "png", 200, 300)
+	info, err := DefaultImageLimits().ReadImage(bytes.NewReader(data))
+	assert.Nil(t, err)
+	assert.Equal(t, "image/png", info.ContentType)
+	assert.Equal(t, 200, info.Width)
+	assert.Equal(t, 300, info.Height)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(data), info.Base64())
+
+	info, err = DefaultImageLimits().ReadImage(bytes.NewReader(testImage(t, "jpeg", 100, 100)))
+	assert.Nil(t, err)
+	assert.Equal(t, "image/jpeg", info.ContentType)
+
+	_, err = DefaultImageLimits().ReadImage(bytes.NewReader(testImage(t, "png", 99, 100)))
+	assert.Contains(t, err.Error(), "99x100 is smaller than 100x100")
+
+	_, err = DefaultImageLimits().ReadImage(bytes.NewReader(testImage(t, "png", 10001, 1)))
+	assert.IsType(t, &ValidationError{}, err)
+
+	_, err = DefaultImageLimits().ReadImage(strings.NewReader("GIF89a"))
+	assert.IsType(t, &ValidationError{}, err)
+	assert.Contains(t, err.Error(), "must be a jpeg or png")
+
+	_, err = DefaultImageLimits().ReadImage(bytes.NewReader(data[:20]))
+	assert.Contains(t, err.Error(), "broken image/png")
+
+	_, err = ImageLimits{MaxBytes: 10}.ReadImage(bytes.NewReader(data))
+	assert.Contains(t, err.Error(), "larger than 10 bytes")
+
+	_, err = ImageLimits{MinWidth: 1000, MinHeight: 1000}.ReadImage(bytes.NewReader(data))
+	assert.Contains(t, err.Error(), "200x300 is smaller than 1000x1000")
+
+	_, err = ImageLimits{MaxWidth: 100, MaxHeight: 100}.ReadImage(bytes.NewReader(data))
+	assert.Contains(t, err.Error(), "200x300 is larger than 100x100")
+
+	// the defaults are not shared
+	limits := DefaultImageLimits()
+	limits.MaxBytes = 10
+	assert.Equal(t, int64(20<<20), DefaultImageLimits().MaxBytes)
+}
+
+func TestNewImageBase64Source(t *testing.T) {
+	data := testImage(t, "jpeg", 100, 100)
+	source, err := NewImageBase64SourceFromReader(bytes.NewReader(data))
+	assert.Nil(t, err)
+	assert.Equal(t, PinSourceImageBase64, source.SourceType)
+	assert.Equal(t, "image/jpeg", source.ContentType)
+	assert.Nil(t, source.Validate())
+
+	name := filepath.Join(t.TempDir(), "image.jpg")
+	os.WriteFile(name, data, 0600)
+	source, err = NewImageBase64SourceFromFile(name)
+	assert.Nil(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(data), source.Data)
+
+	_, err = NewImageBase64SourceFromFile(name + ".missing")
+	assert.True(t, os.IsNotExist(err))
+	_, err = NewImageBase64SourceFromReader(strings.NewReader("text"))
+	assert.NotNil(t, err)
+
+	item, err := NewCarouselItemFromReader(bytes.NewReader(testImage(t, "png", 100, 100)))
+	assert.Nil(t, err)
+	assert.Nil(t, NewMultipleImageBase64Source(item, item).Validate())
+	_, err = NewCarouselItemFromReader(strings.NewReader("text"))
+	assert.NotNil(t, err)
+}
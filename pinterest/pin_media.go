@@ -0,0 +1,123 @@
+package pinterest
+
+import "fmt"
+
+/*
+	Media source for create pin
+	Refer: https://developers.pinterest.com/docs/api/v5/#operation/pins/create
+*/
+
+// The item count limits for the carousel pins.
+const (
+	MinCarouselItems = 2
+	MaxCarouselItems = 5
+)
+
+// CarouselItemOpts represents an image of the carousel pin.
+type CarouselItemOpts struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Link        string `json:"link,omitempty"`
+	Url         string `json:"url,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Data        string `json:"data,omitempty"`
+}
+
+// NewImageURLSource Return the media source for an image by url.
+func NewImageURLSource(url string) CreatePinMediaSourceOpts {
+	return CreatePinMediaSourceOpts{SourceType: PinSourceImageURL, Url: url}
+}
+
+// NewImageBase64Source Return the media source for an image by base64 encoded data,
+// the content type should be image/jpeg or image/png.
+func NewImageBase64Source(contentType, data string) CreatePinMediaSourceOpts {
+	return CreatePinMediaSourceOpts{SourceType: PinSourceImageBase64, ContentType: contentType, Data: data}
+}
+
+// NewVideoSource Return the media source for an uploaded video, with the cover image by url.
+func NewVideoSource(mediaID, coverImageURL string) CreatePinMediaSourceOpts {
+	return CreatePinMediaSourceOpts{SourceType: PinSourceVideoID, MediaID: mediaID, CoverImageURL: coverImageURL}
+}
+
+// NewVideoKeyFrameSource Return the media source for an uploaded video, with the cover image
+// taken from the frame at the time in seconds.
+func NewVideoKeyFrameSource(mediaID string, keyFrameTime float64) CreatePinMediaSourceOpts {
+	return CreatePinMediaSourceOpts{SourceType: PinSourceVideoID, MediaID: mediaID, CoverImageKeyFrameTime: &keyFrameTime}
+}
+
+// NewMultipleImageURLsSource Return the media source for a carousel pin with the images by url.
+func NewMultipleImageURLsSource(items ...*CarouselItemOpts) CreatePinMediaSourceOpts {
+	return CreatePinMediaSourceOpts{SourceType: PinSourceMultipleImageURLs, Items: items}
+}
+
+// NewMultipleImageBase64Source Return the media source for a carousel pin with the images by base64 encoded data.
+func NewMultipleImageBase64Source(items ...*CarouselItemOpts) CreatePinMediaSourceOpts {
+	return CreatePinMediaSourceOpts{SourceType: PinSourceMultipleImageBase64, Items: items}
+}
+
+func isImageContentType(contentType string) bool {
+	return contentType == "image/jpeg" || contentType == "image/png"
+}
+
+// Validate Check the required fields for the source type.
+func (o CreatePinMediaSourceOpts) Validate() error {
+	switch o.SourceType {
+	case PinSourceImageURL, PinSourcePinURL:
+		if o.Url == "" {
+			return &ValidationError{Field: "media_source.url", Message: "required for " + string(o.SourceType)}
+		}
+	case PinSourceImageBase64:
+		if !isImageContentType(o.ContentType) {
+			return &ValidationError{Field: "media_source.content_type", Message: "must be image/jpeg or image/png"}
+		}
+		if o.Data == "" {
+			return &ValidationError{Field: "media_source.data", Message: "required for " + string(o.SourceType)}
+		}
+	case PinSourceVideoID:
+		if o.MediaID == "" {
+			return &ValidationError{Field: "media_source.media_id", Message: "required for " + string(o.SourceType)}
+		}
+		if (o.CoverImageURL == "") == (o.CoverImageKeyFrameTime == nil) {
+			return &ValidationError{Field: "media_source.cover_image_url", Message: "one of cover_image_url and cover_image_key_frame_time is required"}
+		}
+		if o.CoverImageKeyFrameTime != nil && *o.CoverImageKeyFrameTime < 0 {
+			return &ValidationError{Field: "media_source.cover_image_key_frame_time", Message: "must not be negative"}
+		}
+	case PinSourceMultipleImageURLs, PinSourceMultipleImageBase64:
+		return o.validateItems()
+	default:
+		return &ValidationError{Field: "media_source.source_type", Message: fmt.Sprintf("unknown source type %q", o.SourceType)}
+	}
+	return nil
+}
+
+func (o CreatePinMediaSourceOpts) validateItems() error {
+	if len(o.Items) < MinCarouselItems || len(o.Items) > MaxCarouselItems {
+		return &ValidationError{
+			Field:   "media_source.items",
+			Message: fmt.Sprintf("must have %d to %d items, got %d", MinCarouselItems, MaxCarouselItems, len(o.Items)),
+		}
+	}
+	if o.Index != nil && (*o.Index < 0 || *o.Index >= len(o.Items)) {
+		return &ValidationError{Field: "media_source.index", Message: "out of the items range"}
+	}
+	for i, item := range o.Items {
+		field := fmt.Sprintf("media_source.items[%d]", i)
+		if item == nil {
+			return &ValidationError{Field: field, Message: "must not be nil"}
+		}
+		if o.SourceType == PinSourceMultipleImageURLs {
+			if item.Url == "" {
+				return &ValidationError{Field: field + ".url", Message: "required for " + string(o.SourceType)}
+			}
+			continue
+		}
+		if !isImageContentType(item.ContentType) {
+			return &ValidationError{Field: field + ".content_type", Message: "must be image/jpeg or image/png"}
+		}
+		if item.Data == "" {
+			return &ValidationError{Field: field + ".data", Message: "required for " + string(o.SourceType)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package pinterest
+
+import (
+	"errors"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestCreatePinMediaSourceValidate(t *testing.T) {
+	url := func(u string) *CarouselItemOpts { return &CarouselItemOpts{Url: u} }
+	png := &CarouselItemOpts{ContentType: "image/png", Data: "iVBORw0KGgo="}
+
+	var tests = []struct {
+		source CreatePinMediaSourceOpts
+		field  string
+	}{
+		{NewImageURLSource("https://xxx.com/image.png"), ""},
+		{NewImageURLSource(""), "media_source.url"},
+		{NewImageBase64Source("image/png", "iVBORw0KGgo="), ""},
+		{NewImageBase64Source("image/gif", "R0lGODlh"), "media_source.content_type"},
+		{NewImageBase64Source("image/jpeg", ""), "media_source.data"},
+		{NewVideoSource("5216393791692388749", "https://xxx.com/cover.png"), ""},
+		{NewVideoKeyFrameSource("5216393791692388749", 1.5), ""},
+		{NewVideoKeyFrameSource("5216393791692388749", -1), "media_source.cover_image_key_frame_time"},
+		{NewVideoSource("", "https://xxx.com/cover.png"), "media_source.media_id"},
+		{NewVideoSource("5216393791692388749", ""), "media_source.cover_image_url"},
+		{NewMultipleImageURLsSource(url("https://xxx.com/1.png"), url("https://xxx.com/2.png")), ""},
+		{NewMultipleImageURLsSource(url("https://xxx.com/1.png")), "media_source.items"},
+		{NewMultipleImageURLsSource(url("1"), url("2"), url("3"), url("4"), url("5"), url("6")), "media_source.items"},
+		{NewMultipleImageURLsSource(url("https://xxx.com/1.png"), url("")), "media_source.items[1].url"},
+		{NewMultipleImageURLsSource(url("https://xxx.com/1.png"), nil), "media_source.items[1]"},
+		{CreatePinMediaSourceOpts{SourceType: PinSourceMultipleImageURLs, Items: []*CarouselItemOpts{url("1"), url("2")}, Index: Int(2)}, "media_source.index"},
+		{NewMultipleImageBase64Source(png, png), ""},
+		{NewMultipleImageBase64Source(png, &CarouselItemOpts{ContentType: "image/png"}), "media_source.items[1].data"},
+		{NewMultipleImageBase64Source(png, &CarouselItemOpts{Data: "abc"}), "media_source.items[1].content_type"},
+		{CreatePinMediaSourceOpts{SourceType: PinSourcePinURL, Url: "https://www.pinterest.com/pin/1/"}, ""},
+		{CreatePinMediaSourceOpts{SourceType: "unknown"}, "media_source.source_type"},
+	}
+
+	for i, tt := range tests {
+		err := tt.source.Validate()
+		if tt.field == "" {
+			assert.Nil(t, err, "%d", i)
+			continue
+		}
+		var validationErr *ValidationError
+		if assert.True(t, errors.As(err, &validationErr), "%d", i) {
+			assert.Equal(t, tt.field, validationErr.Field, "%d", i)
+		}
+	}
+}
+
+func (bc *BCSuite) TestCreateCarouselPin() {
+	var body string
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/pins",
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := io.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewStringResponse(201, `{"id":"1022106077902810180","board_id":"1022106146619699845","media":{"media_type":"multiple_images"}}`), nil
+		},
+	)
+
+	_, err := bc.Pin.Pin.CreatePin(CreatePinOpts{BoardID: "1022106146619699845", MediaSource: NewMultipleImageURLsSource()})
+	bc.IsType(&ValidationError{}, err)
+	bc.Empty(body)
+
+	pin, err := bc.Pin.Pin.CreatePin(CreatePinOpts{
+		BoardID: "1022106146619699845",
+		MediaSource: NewMultipleImageURLsSource(
+			&CarouselItemOpts{Title: "first", Link: "https://xxx.com/1", Url: "https://xxx.com/1.png"},
+			&CarouselItemOpts{Title: "second", Url: "https://xxx.com/2.png"},
+		),
+	})
+	bc.Nil(err)
+	bc.Equal(*pin.Media.MediaType, "multiple_images")
+	bc.JSONEq(`{"board_id":"1022106146619699845","media_source":{"source_type":"multiple_image_urls","items":[{"title":"first","link":"https://xxx.com/1","url":"https://xxx.com/1.png"},{"title":"second","url":"https://xxx.com/2.png"}]}}`, body)
+
+	bc.Pin.Pin.CreatePin(CreatePinOpts{BoardID: "1022106146619699845", MediaSource: NewVideoKeyFrameSource("5216393791692388749", 2)})
+	bc.JSONEq(`{"board_id":"1022106146619699845","media_source":{"source_type":"video_id","media_id":"5216393791692388749","cover_image_key_frame_time":2}}`, body)
+}
@@ -1,6 +1,10 @@
 package pinterest
 
-import "github.com/jarcoal/httpmock"
+import (
+	"github.com/jarcoal/httpmock"
+	"io"
+	"net/http"
+)
 
 func (bc *BCSuite) TestCreatePin() {
 	httpmock.RegisterResponder(
@@ -43,13 +47,14 @@ func (bc *BCSuite) TestGetPin() {
 		HttpGet, Baseurl+"/pins/"+pinID,
 		httpmock.NewStringResponder(
 			200,
-			`{"title":"","board_id":"1022106146619699845","media":{"media_type":"image","images":{"150x150":{"width":150,"height":150,"url":"https://i.pinimg.com/150x150/39/90/d9/3990d935052091b45865fb001609b97e.jpg"},"400x300":{"width":400,"height":300,"url":"https://i.pinimg.com/400x300/39/90/d9/3990d935052091b45865fb001609b97e.jpg"},"600x":{"width":600,"height":893,"url":"https://i.pinimg.com/600x/39/90/d9/3990d935052091b45865fb001609b97e.jpg"},"1200x":{"width":1200,"height":1786,"url":"https://i.pinimg.com/1200x/39/90/d9/3990d935052091b45865fb001609b97e.jpg"},"originals":{"width":1920,"height":2858,"url":"https://i.pinimg.com/originals/39/90/d9/3990d935052091b45865fb001609b97e.jpg"}}},"board_section_id":null,"id":"1022106077902810180","board_owner":{"username":"merleliukun"},"description":" ","alt_text":null,"link":null,"created_at":"2022-02-14T02:54:38"}`,
+			`{"title":"","board_id":"1022106146619699845","media":{"media_type":"image","images":{"150x150":{"width":150,"height":150,"url":"https://i.pinimg.com/150x150/39/90/d9/3990d935052091b45865fb001609b97e.jpg"},"400x300":{"width":400,"height":300,"url":"https://i.pinimg.com/400x300/39/90/d9/3990d935052091b45865fb001609b97e.jpg"},"600x":{"width":600,"height":893,"url":"https://i.pinimg.com/600x/39/90/d9/3990d935052091b45865fb001609b97e.jpg"},"1200x":{"width":1200,"height":1786,"url":"https://i.pinimg.com/1200x/39/90/d9/3990d935052091b45865fb001609b97e.jpg"},"originals":{"width":1920,"height":2858,"url":"https://i.pinimg.com/originals/39/90/d9/3990d935052091b45865fb001609b97e.jpg"}}},"board_section_id":null,"id":"1022106077902810180","board_owner":{"username":"merleliukun"},"description":" ","alt_text":null,"link":null,"created_at":"2022-02-14T02:54:38","creative_type":"REGULAR"}`,
 		),
 	)
 
 	pin, _ := bc.Pin.Pin.GetPin(pinID, "")
 	bc.Equal(*pin.BoardID, "1022106146619699845")
 	bc.Equal(*pin.Media.MediaType, "image")
+	bc.Equal(CreativeTypeRegular, *pin.CreativeType)
 
 	pin, _ = bc.Pin.Pin.GetPin(pinID, "123456")
 	bc.Equal(*pin.BoardID, "1022106146619699845")
@@ -79,3 +84,68 @@ func (bc *BCSuite) TestDeletePin() {
 	err = bc.Pin.Pin.DeletePin(pinID)
 	bc.Nil(err)
 }
+
+func (bc *BCSuite) TestUpdatePin() {
+	pinID := "1022106077902810180"
+	httpmock.RegisterResponder(
+		HttpPatch, Baseurl+"/pins/"+pinID,
+		httpmock.NewStringResponder(
+			404,
+			`{"code":404,"message":"Pin not found."}`,
+		),
+	)
+	_, err := bc.Pin.Pin.UpdatePin(pinID, UpdatePinOpts{Title: String("title")})
+	bc.IsType(&APIError{}, err)
+
+	var body string
+	httpmock.RegisterResponder(
+		HttpPatch, Baseurl+"/pins/"+pinID,
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := io.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewStringResponse(200, `{"id":"1022106077902810180","title":"title","board_id":"1022106146619699845","board_section_id":null}`), nil
+		},
+	)
+
+	pin, _ := bc.Pin.Pin.UpdatePin(pinID, UpdatePinOpts{Title: String("title"), AltText: String("")})
+	bc.Equal(*pin.Title, "title")
+	bc.JSONEq(`{"title":"title","alt_text":null}`, body)
+
+	pin, _ = bc.Pin.Pin.MovePin(pinID, "1022106146619699845", "")
+	bc.Equal(*pin.BoardID, "1022106146619699845")
+	bc.JSONEq(`{"board_id":"1022106146619699845","board_section_id":null}`, body)
+
+	bc.Pin.Pin.MovePin(pinID, "", "5215175925383086784")
+	bc.JSONEq(`{"board_section_id":"5215175925383086784"}`, body)
+
+	// the board can not be cleared
+	body = ""
+	_, err = bc.Pin.Pin.UpdatePin(pinID, UpdatePinOpts{BoardID: String("")})
+	bc.Equal(&ValidationError{Field: "board_id", Message: "can not be cleared"}, err)
+	bc.Equal("", body)
+}
+
+func (bc *BCSuite) TestSavePin() {
+	pinID := "1022106077902810180"
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/pins/"+pinID+"/save",
+		httpmock.NewStringResponder(
+			403,
+			`{"code":403,"message":"Not authorized to access board or Pin."}`,
+		),
+	)
+	_, err := bc.Pin.Pin.SavePin(pinID, SavePinOpts{BoardID: "1022106146619699845"})
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/pins/"+pinID+"/save",
+		httpmock.NewStringResponder(
+			201,
+			`{"id":"1022106077902810181","board_id":"1022106146619699845","board_section_id":"5215175925383086784"}`,
+		),
+	)
+
+	pin, _ := bc.Pin.Pin.SavePin(pinID, SavePinOpts{BoardID: "1022106146619699845", BoardSectionID: "5215175925383086784"})
+	bc.Equal(*pin.ID, "1022106077902810181")
+	bc.Equal(*pin.BoardSectionID, "5215175925383086784")
+}
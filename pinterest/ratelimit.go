@@ -0,0 +1,188 @@
+package pinterest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+	Rate limit
+	Refer: https://developers.pinterest.com/docs/reference/ratelimits/
+*/
+
+// RateLimit represents the rate limit info returned by the response headers.
+type RateLimit struct {
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Reset     time.Time `json:"reset"`
+}
+
+func (r RateLimit) String() string {
+	return fmt.Sprintf("RateLimit{Limit:%d, Remaining:%d, Reset:%s}", r.Limit, r.Remaining, r.Reset.Format(time.RFC3339))
+}
+
+// parseRateLimit Parse the rate limit headers, return nil if the response has no rate limit headers.
+func parseRateLimit(header http.Header, now time.Time) *RateLimit {
+	limit, limitErr := strconv.Atoi(strings.TrimSpace(header.Get("X-RateLimit-Limit")))
+	remaining, remainingErr := strconv.Atoi(strings.TrimSpace(header.Get("X-RateLimit-Remaining")))
+	if limitErr != nil && remainingErr != nil {
+		return nil
+	}
+	rl := &RateLimit{Limit: limit, Remaining: remaining}
+	if reset, ok := parseRateLimitReset(header.Get("X-RateLimit-Reset"), now); ok {
+		rl.Reset = reset
+	}
+	return rl
+}
+
+// RateLimit Return a snapshot of the rate limit info parsed from the response which arrived last.
+// The requests are shared by the client, so with concurrent calls it may belong to any of them,
+// use WithRateLimit to get the rate limit info of a call.
+func (r *Client) RateLimit() RateLimit {
+	r.rateMu.Lock()
+	defer r.rateMu.Unlock()
+	return r.rateLimit
+}
+
+type rateLimitKey struct{}
+
+// WithRateLimit Return a context which makes the calls using it fill rl with the rate limit info of their response.
+// If the request is retried, rl holds the info of the last attempt. rl is not changed if the response has no
+// rate limit headers, and it should not be shared by concurrent calls.
+func WithRateLimit(ctx context.Context, rl *RateLimit) context.Context {
+	return context.WithValue(ctx, rateLimitKey{}, rl)
+}
+
+// setRateLimit Save the rate limit info for Client.RateLimit, and for the call if its context is from WithRateLimit.
+func (r *Client) setRateLimit(ctx context.Context, rl *RateLimit) {
+	if rl == nil {
+		return
+	}
+	r.rateMu.Lock()
+	r.rateLimit = *rl
+	r.rateMu.Unlock()
+	if dst, ok := ctx.Value(rateLimitKey{}).(*RateLimit); ok && dst != nil {
+		*dst = *rl
+	}
+}
+
+// RateLimitCategory represents the category of endpoints sharing a rate limit.
+type RateLimitCategory string
+
+const (
+	RateLimitCategoryOrgRead      RateLimitCategory = "org_read"
+	RateLimitCategoryOrgWrite     RateLimitCategory = "org_write"
+	RateLimitCategoryOrgAnalytics RateLimitCategory = "org_analytics"
+	RateLimitCategoryAdsRead      RateLimitCategory = "ads_read"
+	RateLimitCategoryAdsWrite     RateLimitCategory = "ads_write"
+	RateLimitCategoryAdsAnalytics RateLimitCategory = "ads_analytics"
+)
+
+// rateLimitCategory Return the rate limit category for the request.
+func rateLimitCategory(method, path string) RateLimitCategory {
+	path = strings.TrimPrefix(path, Baseurl)
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	ads := strings.HasPrefix(path, "/ad_accounts")
+	if strings.Contains(path, "/analytics") || strings.Contains(path, "/reports") {
+		if ads {
+			return RateLimitCategoryAdsAnalytics
+		}
+		return RateLimitCategoryOrgAnalytics
+	}
+	write := method != HttpGet
+	switch {
+	case ads && write:
+		return RateLimitCategoryAdsWrite
+	case ads:
+		return RateLimitCategoryAdsRead
+	case write:
+		return RateLimitCategoryOrgWrite
+	default:
+		return RateLimitCategoryOrgRead
+	}
+}
+
+// TokenBucket is a token bucket limiter, it allows requests at rate per second with bursts of at most burst requests.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket Return a full token bucket.
+func NewTokenBucket(ratePerSecond float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   ratePerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve Take a token if available, otherwise return the time to wait for the next token.
+func (b *TokenBucket) reserve(now time.Time) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+	if b.rate <= 0 {
+		return time.Second, false
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second)), false
+}
+
+// Wait Block until a token is available, or the context is done.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		delay, ok := b.reserve(time.Now())
+		if ok {
+			return nil
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// SetRateLimiter Limit the requests of the category to ratePerSecond, with bursts of at most burst requests.
+// The requests will wait for the limiter before sent, so batch jobs slow down before hitting 429.
+func (r *Client) SetRateLimiter(category RateLimitCategory, ratePerSecond float64, burst int) *Client {
+	r.rateMu.Lock()
+	defer r.rateMu.Unlock()
+	if r.limiters == nil {
+		r.limiters = make(map[RateLimitCategory]*TokenBucket)
+	}
+	r.limiters[category] = NewTokenBucket(ratePerSecond, burst)
+	return r
+}
+
+// waitRateLimiter Wait for the limiter of the request category if it has been set.
+func (r *Client) waitRateLimiter(ctx context.Context, method, path string) error {
+	r.rateMu.Lock()
+	limiter := r.limiters[rateLimitCategory(method, path)]
+	r.rateMu.Unlock()
+	if limiter == nil {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
@@ -0,0 +1,143 @@
+package pinterest
+
+import (
+	"context"
+	"errors"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func headerResponder(status int, body string, header http.Header) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		for k, v := range header {
+			resp.Header[k] = v
+		}
+		return resp, nil
+	}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Date(2022, 2, 14, 0, 0, 0, 0, time.UTC)
+	assert.Nil(t, parseRateLimit(http.Header{}, now))
+
+	h := http.Header{}
+	h.Set("X-RateLimit-Limit", "1000")
+	h.Set("X-RateLimit-Remaining", "998")
+	h.Set("X-RateLimit-Reset", "60")
+	rl := parseRateLimit(h, now)
+	assert.Equal(t, 1000, rl.Limit)
+	assert.Equal(t, 998, rl.Remaining)
+	assert.Equal(t, now.Add(time.Minute), rl.Reset)
+	assert.Contains(t, rl.String(), "Remaining:998")
+}
+
+func TestClientRateLimit(t *testing.T) {
+	cli := NewBearerClient("")
+	httpmock.ActivateNonDefault(cli.Cli.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "1000")
+	header.Set("X-RateLimit-Remaining", "999")
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/user_account",
+		headerResponder(200, `{"username":"merleliukun"}`, header),
+	)
+	_, err := cli.UserAccount.GetUserAccount("")
+	assert.Nil(t, err)
+	assert.Equal(t, 999, cli.RateLimit().Remaining)
+
+	header.Set("X-RateLimit-Remaining", "0")
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/user_account",
+		headerResponder(429, `{"code":8,"message":"Too many requests"}`, header),
+	)
+	_, err = cli.UserAccount.GetUserAccount("")
+	assert.Equal(t, 0, err.(*APIError).RateLimit.Remaining)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	assert.Equal(t, 0, cli.RateLimit().Remaining)
+}
+
+func TestWithRateLimit(t *testing.T) {
+	cli := NewBearerClient("")
+	httpmock.ActivateNonDefault(cli.Cli.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	userHeader := http.Header{}
+	userHeader.Set("X-RateLimit-Limit", "1000")
+	userHeader.Set("X-RateLimit-Remaining", "999")
+	httpmock.RegisterResponder(HttpGet, Baseurl+"/user_account", headerResponder(200, `{}`, userHeader))
+	boardHeader := http.Header{}
+	boardHeader.Set("X-RateLimit-Limit", "100")
+	boardHeader.Set("X-RateLimit-Remaining", "10")
+	httpmock.RegisterResponder(HttpGet, Baseurl+"/boards/1", headerResponder(200, `{}`, boardHeader))
+
+	var user, board RateLimit
+	_, err := cli.UserAccount.GetUserAccountWithContext(WithRateLimit(context.Background(), &user), "")
+	assert.Nil(t, err)
+	_, err = cli.Board.GetBoardWithContext(WithRateLimit(context.Background(), &board), "1")
+	assert.Nil(t, err)
+
+	// each call gets the rate limit info of its own response, the client keeps the last one
+	assert.Equal(t, 999, user.Remaining)
+	assert.Equal(t, 10, board.Remaining)
+	assert.Equal(t, 10, cli.RateLimit().Remaining)
+}
+
+func TestRateLimitCategory(t *testing.T) {
+	assert.Equal(t, RateLimitCategoryOrgRead, rateLimitCategory(HttpGet, Baseurl+"/boards"))
+	assert.Equal(t, RateLimitCategoryOrgWrite, rateLimitCategory(HttpPost, "/pins"))
+	assert.Equal(t, RateLimitCategoryOrgAnalytics, rateLimitCategory(HttpGet, "/user_account/analytics"))
+	assert.Equal(t, RateLimitCategoryAdsRead, rateLimitCategory(HttpGet, "/ad_accounts/123/campaigns"))
+	assert.Equal(t, RateLimitCategoryAdsWrite, rateLimitCategory(HttpPatch, "/ad_accounts/123/campaigns"))
+	assert.Equal(t, RateLimitCategoryAdsAnalytics, rateLimitCategory(HttpGet, Baseurl+"/ad_accounts/123/ads/analytics?a=b"))
+}
+
+func TestTokenBucket(t *testing.T) {
+	b := NewTokenBucket(1, 2)
+	now := b.last
+	_, ok := b.reserve(now)
+	assert.True(t, ok)
+	_, ok = b.reserve(now)
+	assert.True(t, ok)
+	delay, ok := b.reserve(now)
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, delay)
+
+	_, ok = b.reserve(now.Add(time.Second))
+	assert.True(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, b.Wait(ctx))
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	cli := NewBearerClient("").SetRateLimiter(RateLimitCategoryOrgWrite, 0.001, 1)
+	httpmock.ActivateNonDefault(cli.Cli.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		HttpDelete, Baseurl+"/pins/123",
+		httpmock.NewStringResponder(204, ``),
+	)
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/pins/123",
+		httpmock.NewStringResponder(200, `{"id":"123"}`),
+	)
+
+	assert.Nil(t, cli.Pin.DeletePin("123"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := cli.Pin.DeletePinWithContext(ctx, "123")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	// other categories are not limited
+	_, err = cli.Pin.GetPin("123", "")
+	assert.Nil(t, err)
+}
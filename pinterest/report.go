@@ -0,0 +1,483 @@
+package pinterest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+	Asynchronous analytics reports
+	Refer: https://developers.pinterest.com/docs/api/v5/#operation/analytics/create_report
+*/
+
+// ReportLevel represents the level of the entities in the report.
+type ReportLevel string
+
+// Report level
+const (
+	ReportLevelAdvertiser       ReportLevel = "ADVERTISER"
+	ReportLevelCampaign         ReportLevel = "CAMPAIGN"
+	ReportLevelAdGroup          ReportLevel = "AD_GROUP"
+	ReportLevelAd               ReportLevel = "AD"
+	ReportLevelPinPromotion     ReportLevel = "PIN_PROMOTION"
+	ReportLevelProductGroup     ReportLevel = "PRODUCT_GROUP"
+	ReportLevelProductItem      ReportLevel = "PRODUCT_ITEM"
+	ReportLevelKeyword          ReportLevel = "KEYWORD"
+	ReportLevelAdvertiserTarget ReportLevel = "ADVERTISER_TARGETING"
+	ReportLevelCampaignTarget   ReportLevel = "CAMPAIGN_TARGETING"
+	ReportLevelAdGroupTarget    ReportLevel = "AD_GROUP_TARGETING"
+)
+
+func (l ReportLevel) IsValid() bool {
+	switch l {
+	case ReportLevelAdvertiser, ReportLevelCampaign, ReportLevelAdGroup, ReportLevelAd, ReportLevelPinPromotion,
+		ReportLevelProductGroup, ReportLevelProductItem, ReportLevelKeyword, ReportLevelAdvertiserTarget,
+		ReportLevelCampaignTarget, ReportLevelAdGroupTarget:
+		return true
+	}
+	return false
+}
+
+// ReportFormat represents the file format of the report.
+type ReportFormat string
+
+// Report format
+const (
+	ReportFormatJSON ReportFormat = "JSON"
+	ReportFormatCSV  ReportFormat = "CSV"
+)
+
+func (f ReportFormat) IsValid() bool {
+	return f == ReportFormatJSON || f == ReportFormatCSV
+}
+
+// ReportStatus represents the status of the report.
+type ReportStatus string
+
+// Report status
+const (
+	ReportStatusInProgress   ReportStatus = "IN_PROGRESS"
+	ReportStatusFinished     ReportStatus = "FINISHED"
+	ReportStatusFailed       ReportStatus = "FAILED"
+	ReportStatusCancelled    ReportStatus = "CANCELLED"
+	ReportStatusExpired      ReportStatus = "EXPIRED"
+	ReportStatusDoesNotExist ReportStatus = "DOES_NOT_EXIST"
+)
+
+func (s ReportStatus) IsValid() bool {
+	switch s {
+	case ReportStatusInProgress, ReportStatusFinished, ReportStatusFailed, ReportStatusCancelled, ReportStatusExpired,
+		ReportStatusDoesNotExist:
+		return true
+	}
+	return false
+}
+
+// ErrReportFailed is returned when the report is not finished and will never be.
+var ErrReportFailed = errors.New("pinterest: report failed")
+
+// CreateReportOpts represents the parameters for create a report.
+type CreateReportOpts struct {
+	StartDate              string            `json:"start_date"`
+	EndDate                string            `json:"end_date"`
+	Granularity            Granularity       `json:"granularity"`
+	Columns                []AnalyticsColumn `json:"columns"`
+	Level                  ReportLevel       `json:"level"`
+	ReportFormat           ReportFormat      `json:"report_format,omitempty"`
+	ClickWindowDays        int               `json:"click_window_days,omitempty"`
+	EngagementWindowDays   int               `json:"engagement_window_days,omitempty"`
+	ViewWindowDays         int               `json:"view_window_days,omitempty"`
+	ConversionReportTime   string            `json:"conversion_report_time,omitempty"`
+	CampaignIDs            []string          `json:"campaign_ids,omitempty"`
+	CampaignStatuses       []EntityStatus    `json:"campaign_statuses,omitempty"`
+	CampaignObjectiveTypes []ObjectiveType   `json:"campaign_objective_types,omitempty"`
+	AdGroupIDs             []string          `json:"ad_group_ids,omitempty"`
+	AdGroupStatuses        []EntityStatus    `json:"ad_group_statuses,omitempty"`
+	AdIDs                  []string          `json:"ad_ids,omitempty"`
+	AdStatuses             []EntityStatus    `json:"ad_statuses,omitempty"`
+	ProductGroupIDs        []string          `json:"product_group_ids,omitempty"`
+}
+
+// Validate Check the parameters before sending the request.
+func (c CreateReportOpts) Validate() error {
+	if c.StartDate == "" {
+		return &ValidationError{Field: "start_date", Message: "required"}
+	}
+	if c.EndDate == "" {
+		return &ValidationError{Field: "end_date", Message: "required"}
+	}
+	if len(c.Columns) == 0 {
+		return &ValidationError{Field: "columns", Message: "required"}
+	}
+	if c.Granularity == "" {
+		return &ValidationError{Field: "granularity", Message: "required"}
+	}
+	if err := validateAnalytics(c.Columns, c.Granularity); err != nil {
+		return err
+	}
+	if c.Level == "" {
+		return &ValidationError{Field: "level", Message: "required"}
+	}
+	if err := validateEnum("level", c.Level); err != nil {
+		return err
+	}
+	if err := validateEnum("report_format", c.ReportFormat); err != nil {
+		return err
+	}
+	if err := validateEnums("campaign_statuses", c.CampaignStatuses); err != nil {
+		return err
+	}
+	if err := validateEnums("campaign_objective_types", c.CampaignObjectiveTypes); err != nil {
+		return err
+	}
+	if err := validateEnums("ad_group_statuses", c.AdGroupStatuses); err != nil {
+		return err
+	}
+	return validateEnums("ad_statuses", c.AdStatuses)
+}
+
+// CreateReportResponse represents the response for create a report.
+type CreateReportResponse struct {
+	ReportStatus *ReportStatus `json:"report_status"`
+	Token        *string       `json:"token"`
+	Message      *string       `json:"message"`
+}
+
+func (c CreateReportResponse) String() string {
+	return Stringify(c)
+}
+
+// Report represents the report info, the URL is set once the report is finished.
+type Report struct {
+	ReportStatus *ReportStatus `json:"report_status"`
+	URL          *string       `json:"url"`
+	Size         *int64        `json:"size"`
+}
+
+func (r Report) String() string {
+	return Stringify(r)
+}
+
+// CreateReport Create an asynchronous report, the returned token is used to get the report.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/analytics/create_report
+func (r *AdAccountResource) CreateReport(adAccountID string, args CreateReportOpts) (*CreateReportResponse, error) {
+	return r.CreateReportWithContext(context.Background(), adAccountID, args)
+}
+
+// CreateReportWithContext is the same as CreateReport, but with a context for the request.
+func (r *AdAccountResource) CreateReportWithContext(ctx context.Context, adAccountID string, args CreateReportOpts) (*CreateReportResponse, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/ad_accounts/" + adAccountID + "/reports"
+
+	resp := new(CreateReportResponse)
+	err := r.Cli.DoPostWithContext(ctx, path, args, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// getReportOpts represents the parameters for get a report.
+type getReportOpts struct {
+	Token string `url:"token"`
+}
+
+// GetReport Get the status of the report, and the url to download it once finished.
+// Refer: https://developers.pinterest.com/docs/api/v5/#operation/analytics/get_report
+func (r *AdAccountResource) GetReport(adAccountID, token string) (*Report, error) {
+	return r.GetReportWithContext(context.Background(), adAccountID, token)
+}
+
+// GetReportWithContext is the same as GetReport, but with a context for the request.
+func (r *AdAccountResource) GetReportWithContext(ctx context.Context, adAccountID, token string) (*Report, error) {
+	path := "/ad_accounts/" + adAccountID + "/reports"
+
+	resp := new(Report)
+	err := r.Cli.DoGetWithContext(ctx, path, getReportOpts{Token: token}, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReportOpts represents the options for wait and download the report.
+type ReportOpts struct {
+	// PollInterval the first interval to check the report status, doubled after each check. Default to 2 seconds.
+	PollInterval time.Duration
+	// MaxPollInterval the max interval to check the report status, default to 1 minute.
+	MaxPollInterval time.Duration
+	// Timeout for waiting the report finished, default to 30 minutes.
+	Timeout time.Duration
+	// HTTPClient the client to download the report file, default to http.DefaultClient.
+	// Note it should not carry the Pinterest authorization.
+	HTTPClient *http.Client
+}
+
+// WaitReport Poll the report status with backoff until it is finished.
+// ErrReportFailed is returned along with the report if it failed, is cancelled or expired.
+func (r *AdAccountResource) WaitReport(ctx context.Context, adAccountID, token string, opts ReportOpts) (*Report, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 2 * time.Second
+	}
+	if opts.MaxPollInterval <= 0 {
+		opts.MaxPollInterval = time.Minute
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 30 * time.Minute
+	}
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	interval := opts.PollInterval
+	for {
+		report, err := r.GetReportWithContext(ctx, adAccountID, token)
+		if err != nil {
+			return nil, err
+		}
+		if report.ReportStatus != nil {
+			switch *report.ReportStatus {
+			case ReportStatusFinished:
+				return report, nil
+			case ReportStatusFailed, ReportStatusCancelled, ReportStatusExpired, ReportStatusDoesNotExist:
+				return report, fmt.Errorf("%w: %s", ErrReportFailed, *report.ReportStatus)
+			}
+		}
+		if err = sleepContext(ctx, interval); err != nil {
+			return report, err
+		}
+		if interval *= 2; interval > opts.MaxPollInterval {
+			interval = opts.MaxPollInterval
+		}
+	}
+}
+
+// DownloadReport Download the finished report and return a reader parsing the rows as they are read.
+// The format is detected by the content, so both the CSV and JSON reports are supported.
+// The reader must be closed.
+func (r *AdAccountResource) DownloadReport(ctx context.Context, report *Report, opts ReportOpts) (*ReportReader, error) {
+	if report.URL == nil {
+		return nil, errors.New("pinterest: no url for the report")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, HttpGet, *report.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	hc := opts.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, &TransportError{Method: HttpGet, URL: *report.URL, Err: err}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, &APIError{Code: resp.StatusCode, Message: strings.TrimSpace(string(data)), StatusCode: resp.StatusCode}
+	}
+	return NewReportReader(resp.Body), nil
+}
+
+// RunReport Create the report, wait until it is finished, and return a reader for the rows.
+// The reader must be closed.
+//
+//	rows, err := client.AdAccount.RunReport(ctx, adAccountID, opts, pinterest.ReportOpts{})
+//	if err != nil {
+//		// handle error
+//	}
+//	defer rows.Close()
+//	for rows.Next() {
+//		row := rows.Item()
+//	}
+//	if err := rows.Err(); err != nil {
+//		// handle error
+//	}
+func (r *AdAccountResource) RunReport(ctx context.Context, adAccountID string, args CreateReportOpts, opts ReportOpts) (*ReportReader, error) {
+	created, err := r.CreateReportWithContext(ctx, adAccountID, args)
+	if err != nil {
+		return nil, err
+	}
+	if created.Token == nil {
+		return nil, errors.New("pinterest: no token for the created report")
+	}
+	report, err := r.WaitReport(ctx, adAccountID, *created.Token, opts)
+	if err != nil {
+		return nil, err
+	}
+	return r.DownloadReport(ctx, report, opts)
+}
+
+// ReportReader reads the rows of the report one by one, so the large reports are not loaded in memory.
+// The JSON reports may be an array of rows, or an object with the arrays of rows by the entity id.
+type ReportReader struct {
+	r    io.ReadCloser
+	next func() (*AnalyticsRow, error)
+	item *AnalyticsRow
+	err  error
+}
+
+// NewReportReader Return a reader for the report content in CSV or JSON format.
+func NewReportReader(r io.ReadCloser) *ReportReader {
+	rr := &ReportReader{r: r}
+	rr.next = rr.detect
+	return rr
+}
+
+// detect Detect the format by the first non space byte, then read the first row.
+func (rr *ReportReader) detect() (*AnalyticsRow, error) {
+	br := bufio.NewReader(rr.r)
+	if bom, _ := br.Peek(3); bytes.Equal(bom, []byte("\xEF\xBB\xBF")) {
+		br.Discard(3)
+	}
+	var b byte
+	for {
+		head, err := br.Peek(1)
+		if err != nil {
+			return nil, err
+		}
+		if b = head[0]; b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+			break
+		}
+		br.Discard(1)
+	}
+	if b == '[' || b == '{' {
+		rr.next = jsonReportRows(json.NewDecoder(br))
+	} else {
+		rr.next = csvReportRows(csv.NewReader(br))
+	}
+	return rr.next()
+}
+
+// Next Advance to the next row, it returns false at the end of the report or on error. Check Err after Next returns false.
+func (rr *ReportReader) Next() bool {
+	if rr.err != nil {
+		return false
+	}
+	row, err := rr.next()
+	if err != nil {
+		rr.err = err
+		return false
+	}
+	rr.item = row
+	return true
+}
+
+// Item Return the current row.
+func (rr *ReportReader) Item() *AnalyticsRow {
+	return rr.item
+}
+
+// Err Return the error stopped the reader, nil at the end of the report.
+func (rr *ReportReader) Err() error {
+	if rr.err == io.EOF {
+		return nil
+	}
+	return rr.err
+}
+
+// All Return all the remaining rows, the read rows are returned along with the error.
+func (rr *ReportReader) All() (AnalyticsResponse, error) {
+	var rows AnalyticsResponse
+	for rr.Next() {
+		rows = append(rows, rr.Item())
+	}
+	return rows, rr.Err()
+}
+
+// Close Close the underlying report content.
+func (rr *ReportReader) Close() error {
+	return rr.r.Close()
+}
+
+// jsonReportRows Return the function reading the rows from the JSON report.
+func jsonReportRows(dec *json.Decoder) func() (*AnalyticsRow, error) {
+	// the rows are the objects in an array, the arrays may be the values of an object by the entity id.
+	var inObject, inArray bool
+	return func() (*AnalyticsRow, error) {
+		for {
+			if inArray && dec.More() {
+				row := new(AnalyticsRow)
+				if err := dec.Decode(row); err != nil {
+					return nil, err
+				}
+				return row, nil
+			}
+			token, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			switch token {
+			case json.Delim('['):
+				inArray = true
+			case json.Delim(']'):
+				inArray = false
+				if !inObject {
+					return nil, io.EOF
+				}
+			case json.Delim('{'):
+				inObject = true
+			case json.Delim('}'):
+				return nil, io.EOF
+			default:
+				// the entity id key of the object
+				if _, ok := token.(string); !ok || !inObject {
+					return nil, fmt.Errorf("pinterest: unexpected token %v in the report", token)
+				}
+			}
+		}
+	}
+}
+
+// csvReportRows Return the function reading the rows from the CSV report, the first line is the columns.
+func csvReportRows(r *csv.Reader) func() (*AnalyticsRow, error) {
+	r.ReuseRecord = true
+	var header []string
+	return func() (*AnalyticsRow, error) {
+		if header == nil {
+			record, err := r.Read()
+			if err != nil {
+				return nil, err
+			}
+			header = make([]string, len(record))
+			for i, col := range record {
+				header[i] = strings.TrimSpace(col)
+			}
+		}
+		record, err := r.Read()
+		if err != nil {
+			return nil, err
+		}
+		columns := make(map[string]json.RawMessage, len(header))
+		for i, col := range header {
+			if i < len(record) {
+				columns[col] = csvValue(record[i])
+			}
+		}
+		return newAnalyticsRow(columns)
+	}
+}
+
+// csvValue Return the JSON value for the CSV cell, the numbers are kept as numbers and the empty cells are null.
+func csvValue(s string) json.RawMessage {
+	if s == "" {
+		return json.RawMessage("null")
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil && json.Valid([]byte(s)) {
+		return json.RawMessage(s)
+	}
+	data, _ := json.Marshal(s)
+	return data
+}
@@ -0,0 +1,187 @@
+package pinterest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func reportOpts() CreateReportOpts {
+	return CreateReportOpts{
+		StartDate:    "2022-02-01",
+		EndDate:      "2022-02-02",
+		Granularity:  GranularityDay,
+		Columns:      []AnalyticsColumn{ColumnCampaignID, ColumnSpendInDollar, ColumnTotalImpression},
+		Level:        ReportLevelCampaign,
+		ReportFormat: ReportFormatCSV,
+	}
+}
+
+func TestCreateReportOptsValidate(t *testing.T) {
+	assert.Nil(t, reportOpts().Validate())
+
+	opts := reportOpts()
+	opts.Level = ""
+	assert.Equal(t, &ValidationError{Field: "level", Message: "required"}, opts.Validate())
+
+	opts = reportOpts()
+	opts.ReportFormat = "XML"
+	var ve *ValidationError
+	assert.True(t, errors.As(opts.Validate(), &ve))
+	assert.Equal(t, "report_format", ve.Field)
+
+	opts = reportOpts()
+	opts.CampaignStatuses = []EntityStatus{EntityStatusActive, "RUNNING"}
+	assert.True(t, errors.As(opts.Validate(), &ve))
+	assert.Equal(t, "campaign_statuses[1]", ve.Field)
+}
+
+func (bc *BCSuite) TestCreateReport() {
+	path := "/ad_accounts/549755885175/reports"
+
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+path,
+		httpmock.NewStringResponder(400, `{"code":1,"message":"Invalid parameters."}`),
+	)
+	_, err := bc.Pin.AdAccount.CreateReport("549755885175", reportOpts())
+	bc.IsType(&APIError{}, err)
+
+	var sent map[string]interface{}
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+path,
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(data, &sent)
+			return httpmock.NewStringResponse(200, `{"report_status":"IN_PROGRESS","token":"report-token","message":""}`), nil
+		},
+	)
+	resp, err := bc.Pin.AdAccount.CreateReport("549755885175", reportOpts())
+	bc.Nil(err)
+	bc.Equal(ReportStatusInProgress, *resp.ReportStatus)
+	bc.Equal("report-token", *resp.Token)
+	bc.Equal("CAMPAIGN", sent["level"])
+	bc.Equal("CSV", sent["report_format"])
+	bc.NotContains(sent, "campaign_ids")
+}
+
+func (bc *BCSuite) TestGetReport() {
+	path := "/ad_accounts/549755885175/reports"
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+path,
+		httpmock.NewStringResponder(404, `{"code":2,"message":"Report not found."}`),
+	)
+	_, err := bc.Pin.AdAccount.GetReport("549755885175", "report-token")
+	bc.IsType(&APIError{}, err)
+
+	httpmock.RegisterResponderWithQuery(
+		HttpGet, Baseurl+path, "token=report-token",
+		httpmock.NewStringResponder(200, `{"report_status":"FINISHED","url":"https://example.com/report.csv","size":1024}`),
+	)
+	report, err := bc.Pin.AdAccount.GetReport("549755885175", "report-token")
+	bc.Nil(err)
+	bc.Equal(ReportStatusFinished, *report.ReportStatus)
+	bc.Equal("https://example.com/report.csv", *report.URL)
+	bc.Equal(int64(1024), *report.Size)
+}
+
+func (bc *BCSuite) TestRunReport() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("\xEF\xBB\xBFCAMPAIGN_ID,DATE,SPEND_IN_DOLLAR,TOTAL_IMPRESSION,NOTE\n" +
+			"626736533506,2022-02-01,1.5,100,\"a, b\"\n" +
+			"626736533506,2022-02-02,,200,\n"))
+	}))
+	defer ts.Close()
+
+	path := "/ad_accounts/549755885175/reports"
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+path,
+		httpmock.NewStringResponder(200, `{"report_status":"IN_PROGRESS","token":"report-token"}`),
+	)
+	polls := 0
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+path,
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			if polls < 3 {
+				return httpmock.NewStringResponse(200, `{"report_status":"IN_PROGRESS"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"report_status":"FINISHED","url":"`+ts.URL+`"}`), nil
+		},
+	)
+
+	rows, err := bc.Pin.AdAccount.RunReport(context.Background(), "549755885175", reportOpts(), ReportOpts{PollInterval: time.Millisecond})
+	bc.Nil(err)
+	defer rows.Close()
+	all, err := rows.All()
+	bc.Nil(err)
+	bc.Equal(3, polls)
+	bc.Len(all, 2)
+	bc.Equal("626736533506", *all[0].CampaignID)
+	bc.Equal("2022-02-01", all[0].Date.Format(DateLayout))
+	bc.Equal(1.5, *all[0].SpendInDollar)
+	bc.Equal(int64(100), *all[0].TotalImpression)
+	note, _ := all[0].Text("NOTE")
+	bc.Equal("a, b", note)
+	bc.Nil(all[1].SpendInDollar)
+	bc.Equal(int64(200), *all[1].TotalImpression)
+}
+
+func (bc *BCSuite) TestWaitReportFailed() {
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/ad_accounts/549755885175/reports",
+		httpmock.NewStringResponder(200, `{"report_status":"EXPIRED"}`),
+	)
+	report, err := bc.Pin.AdAccount.WaitReport(context.Background(), "549755885175", "report-token", ReportOpts{PollInterval: time.Millisecond})
+	bc.True(errors.Is(err, ErrReportFailed))
+	bc.Equal(ReportStatusExpired, *report.ReportStatus)
+}
+
+func (bc *BCSuite) TestDownloadReportError() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	_, err := bc.Pin.AdAccount.DownloadReport(context.Background(), &Report{URL: &ts.URL}, ReportOpts{})
+	var apiErr *APIError
+	bc.True(errors.As(err, &apiErr))
+	bc.Equal(http.StatusForbidden, apiErr.StatusCode)
+}
+
+func TestReportReaderJSON(t *testing.T) {
+	// rows grouped by the entity id
+	rows, err := NewReportReader(io.NopCloser(strings.NewReader(`{
+		"626736533506": [{"CAMPAIGN_ID":626736533506,"DATE":"2022-02-01","TOTAL_IMPRESSION":100}],
+		"626736533507": [{"CAMPAIGN_ID":626736533507,"DATE":"2022-02-01","TOTAL_IMPRESSION":5},
+			{"CAMPAIGN_ID":626736533507,"DATE":"2022-02-02","TOTAL_IMPRESSION":6}]
+	}`))).All()
+	assert.Nil(t, err)
+	assert.Len(t, rows, 3)
+	assert.Equal(t, "626736533507", *rows[2].CampaignID)
+	assert.Equal(t, int64(6), *rows[2].TotalImpression)
+
+	// array of rows
+	rows, err = NewReportReader(io.NopCloser(strings.NewReader(` [{"AD_ID":"1","SPEND_IN_DOLLAR":1.2},{"AD_ID":"2"}]`))).All()
+	assert.Nil(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "2", *rows[1].AdID)
+
+	// empty report
+	rows, err = NewReportReader(io.NopCloser(strings.NewReader(""))).All()
+	assert.Nil(t, err)
+	assert.Len(t, rows, 0)
+
+	// invalid value, the read rows are returned
+	rows, err = NewReportReader(io.NopCloser(strings.NewReader(`[{"AD_ID":"1"},{"TOTAL_IMPRESSION":"many"}]`))).All()
+	assert.NotNil(t, err)
+	assert.Len(t, rows, 1)
+}
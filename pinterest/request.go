@@ -1,30 +1,16 @@
 package pinterest
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"github.com/go-resty/resty/v2"
 	goquery "github.com/google/go-querystring/query"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 )
 
-// APIError represents the error response
-type APIError struct {
-	Code         int    `json:"code"`
-	Message      string `json:"message"`
-	Status       string `json:"status,omitempty"`
-	Data         string `json:"data,omitempty"`
-	EndpointName string `json:"endpoint_name,omitempty"`
-}
-
-func (e APIError) Error() string {
-	return fmt.Sprintf("Pinterest Error, Code: %d Message: %s", e.Code, e.Message)
-}
-
-func (e APIError) String() string {
-	return Stringify(e)
-}
-
 // ListOptions specifies the optional parameters to various List methods that
 // support offset pagination.
 type ListOptions struct {
@@ -35,7 +21,7 @@ type ListOptions struct {
 /*
 	functions for http requests
 */
-func ParseDataResponse(response *resty.Response, d interface{}) *APIError {
+func ParseDataResponse(response *resty.Response, d interface{}) error {
 	var err error
 	if response.IsSuccess() {
 		switch d := d.(type) {
@@ -44,64 +30,123 @@ func ParseDataResponse(response *resty.Response, d interface{}) *APIError {
 			err = json.Unmarshal(response.Body(), d)
 		}
 		if err != nil {
-			return &APIError{Code: -1, Message: err.Error()}
+			return &DecodeError{StatusCode: response.StatusCode(), Body: response.Body(), Err: err}
 		}
 		return nil
 	}
 	apiErr := new(APIError)
 	err = json.Unmarshal(response.Body(), &apiErr)
 	if err != nil {
-		return &APIError{Code: -1, Message: err.Error()}
+		// Not a json error response, keep the body as the message.
+		apiErr = &APIError{Code: response.StatusCode(), Message: strings.TrimSpace(string(response.Body()))}
+		if apiErr.Message == "" {
+			apiErr.Message = response.Status()
+		}
 	}
+	apiErr.StatusCode = response.StatusCode()
+	apiErr.RequestID = requestID(response.Header())
 	return apiErr
 }
 
-func (r *Client) Do(method, path string, queryParams interface{}, jsonParams interface{}, d interface{}) *APIError {
-	req := r.Cli.R()
+// requestID Return the request id from the response headers.
+func requestID(header http.Header) string {
+	if id := header.Get("X-Request-Id"); id != "" {
+		return id
+	}
+	return header.Get("X-Pinterest-Rid")
+}
+
+func (r *Client) Do(method, path string, queryParams interface{}, jsonParams interface{}, d interface{}) error {
+	return r.DoWithContext(context.Background(), method, path, queryParams, jsonParams, d)
+}
 
+// DoWithContext is the same as Do, but the request is bound to ctx, so it is aborted
+// when ctx is cancelled or its deadline expires.
+func (r *Client) DoWithContext(ctx context.Context, method, path string, queryParams interface{}, jsonParams interface{}, d interface{}) error {
 	// parse struct params
+	var query url.Values
 	if queryParams != nil {
 		v, err := goquery.Values(queryParams)
 		if err != nil {
-			apiError := APIError{Code: -1, Message: err.Error()}
-			return &apiError
+			return &EncodeError{Err: err}
 		}
-		req.SetQueryParamsFromValues(v)
-	}
-	if jsonParams != nil {
-		req.SetBody(jsonParams)
-		req.SetHeader("Content-Type", "application/json")
+		query = v
 	}
 
 	// If the only path, add the domain.
-	var url string
+	var reqURL string
 	if strings.HasPrefix(path, "http") {
-		url = path
+		reqURL = path
 	} else {
-		url = Baseurl + path
+		reqURL = Baseurl + path
 	}
 
-	resp, err := req.Execute(method, url)
+	var resp *resty.Response
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = r.waitRateLimiter(ctx, method, reqURL); err != nil {
+			break
+		}
+		req := r.Cli.R().SetContext(ctx)
+		if query != nil {
+			req.SetQueryParamsFromValues(query)
+		}
+		if jsonParams != nil {
+			req.SetBody(jsonParams)
+			req.SetHeader("Content-Type", "application/json")
+		}
+
+		resp, err = req.Execute(method, reqURL)
+		if err == nil {
+			r.setRateLimit(ctx, parseRateLimit(resp.Header(), time.Now()))
+		}
+		delay, retry := r.RetryPolicy.shouldRetry(ctx, method, attempt, resp, err)
+		if !retry {
+			break
+		}
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			err = sleepErr
+			break
+		}
+	}
 	if err != nil {
-		apiError := APIError{Code: -1, Message: err.Error()}
-		return &apiError
+		return &TransportError{Method: method, URL: reqURL, Err: err}
 	}
-	apiError := ParseDataResponse(resp, d)
-	return apiError
+	err = ParseDataResponse(resp, d)
+	if apiErr, ok := err.(*APIError); ok {
+		apiErr.RateLimit = parseRateLimit(resp.Header(), time.Now())
+	}
+	return err
+}
+
+func (r *Client) DoGet(path string, queryParams interface{}, d interface{}) error {
+	return r.DoGetWithContext(context.Background(), path, queryParams, d)
+}
+
+func (r *Client) DoGetWithContext(ctx context.Context, path string, queryParams interface{}, d interface{}) error {
+	return r.DoWithContext(ctx, HttpGet, path, queryParams, nil, d)
+}
+
+func (r *Client) DoPost(path string, jsonParams interface{}, d interface{}) error {
+	return r.DoPostWithContext(context.Background(), path, jsonParams, d)
+}
+
+func (r *Client) DoPostWithContext(ctx context.Context, path string, jsonParams interface{}, d interface{}) error {
+	return r.DoWithContext(ctx, HttpPost, path, nil, jsonParams, d)
 }
 
-func (r *Client) DoGet(path string, queryParams interface{}, d interface{}) *APIError {
-	return r.Do(HttpGet, path, queryParams, nil, d)
+func (r *Client) DoPatch(path string, jsonParams interface{}, d interface{}) error {
+	return r.DoPatchWithContext(context.Background(), path, jsonParams, d)
 }
 
-func (r *Client) DoPost(path string, jsonParams interface{}, d interface{}) *APIError {
-	return r.Do(HttpPost, path, nil, jsonParams, d)
+func (r *Client) DoPatchWithContext(ctx context.Context, path string, jsonParams interface{}, d interface{}) error {
+	return r.DoWithContext(ctx, HttpPatch, path, nil, jsonParams, d)
 }
 
-func (r *Client) DoPatch(path string, jsonParams interface{}, d interface{}) *APIError {
-	return r.Do(HttpPatch, path, nil, jsonParams, d)
+func (r *Client) DoDelete(path string, d interface{}) error {
+	return r.DoDeleteWithContext(context.Background(), path, d)
 }
 
-func (r *Client) DoDelete(path string, d interface{}) *APIError {
-	return r.Do(HttpDelete, path, nil, nil, d)
+func (r *Client) DoDeleteWithContext(ctx context.Context, path string, d interface{}) error {
+	return r.DoWithContext(ctx, HttpDelete, path, nil, nil, d)
 }
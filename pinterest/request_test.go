@@ -1,10 +1,15 @@
 package pinterest
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/go-resty/resty/v2"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestParseDataResponse(t *testing.T) {
@@ -14,12 +19,36 @@ func TestParseDataResponse(t *testing.T) {
 	assert.Nil(t, err)
 
 	err = ParseDataResponse(&resp, "123")
-	assert.IsType(t, &APIError{}, err)
+	assert.IsType(t, &DecodeError{}, err)
 
-	errResp := resty.Response{RawResponse: &http.Response{StatusCode: 403}}
+	errResp := resty.Response{RawResponse: &http.Response{StatusCode: 403, Status: "403 Forbidden", Header: http.Header{"X-Request-Id": {"abc"}}}}
 
 	err = ParseDataResponse(&errResp, "123")
 	assert.IsType(t, &APIError{}, err)
+	assert.True(t, errors.Is(err, ErrForbiddenScope))
+	assert.Equal(t, "403 Forbidden", err.(*APIError).Message)
+	assert.Equal(t, "abc", err.(*APIError).RequestID)
+}
+
+func TestErrors(t *testing.T) {
+	var err error = &APIError{StatusCode: 404, Message: "Pin not found."}
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrUnauthorized))
+	assert.True(t, errors.Is(&APIError{StatusCode: 401}, ErrUnauthorized))
+	assert.True(t, errors.Is(&APIError{StatusCode: 429}, ErrRateLimited))
+	assert.Nil(t, (&APIError{StatusCode: 400}).Unwrap())
+
+	var apiErr *APIError
+	assert.True(t, errors.As(fmt.Errorf("wrapped: %w", err), &apiErr))
+	assert.Equal(t, "Pin not found.", apiErr.Message)
+
+	cause := errors.New("cause")
+	assert.True(t, errors.Is(&TransportError{Method: HttpGet, URL: Baseurl, Err: cause}, cause))
+	assert.True(t, errors.Is(&DecodeError{Err: cause}, cause))
+	assert.True(t, errors.Is(&EncodeError{Err: cause}, cause))
+	assert.Contains(t, (&TransportError{Method: HttpGet, URL: Baseurl, Err: cause}).Error(), "GET "+Baseurl)
+	assert.Contains(t, (&DecodeError{StatusCode: 200, Err: cause}).Error(), "status 200")
+	assert.Contains(t, (&EncodeError{Err: cause}).Error(), "cause")
 }
 
 func TestDo(t *testing.T) {
@@ -27,11 +56,36 @@ func TestDo(t *testing.T) {
 	cli.Cli.SetTimeout(1)
 
 	err := cli.Do("DELETE", "", "", "", "")
-	assert.IsType(t, &APIError{}, err)
+	assert.IsType(t, &EncodeError{}, err)
 
 	err = cli.Do("DELETE", "", nil, "", "")
-	assert.IsType(t, &APIError{}, err)
+	assert.IsType(t, &TransportError{}, err)
 
 	err = cli.Do("GET", "https://127.0.0.1:1234", nil, nil, "")
-	assert.IsType(t, &APIError{}, err)
+	assert.IsType(t, &TransportError{}, err)
+}
+
+func TestDoWithContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+		w.Write([]byte(`{"username":"merleliukun"}`))
+	}))
+	defer ts.Close()
+
+	cli := NewBearerClient("")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := cli.DoGetWithContext(ctx, ts.URL+"/slow", nil, nil)
+	assert.IsType(t, &TransportError{}, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	u := new(UserAccount)
+	err = cli.DoGetWithContext(context.Background(), ts.URL, nil, u)
+	assert.Nil(t, err)
+	assert.Equal(t, *u.Username, "merleliukun")
 }
@@ -0,0 +1,167 @@
+package pinterest
+
+import (
+	"context"
+	"github.com/go-resty/resty/v2"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+	Retry for the failed requests
+*/
+
+// RetryPolicy represents the policy for retrying failed requests.
+// Only requests with a method in RetryableMethods are retried, so POST requests, which are not
+// idempotent, are never retried unless it is added explicitly.
+type RetryPolicy struct {
+	// MaxAttempts is the max number of attempts for a request, including the first one.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, it doubles for every next retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff delay. If the server asks to wait longer, e.g. by Retry-After,
+	// the request is not retried and the error is returned.
+	MaxDelay time.Duration
+	// Jitter is the fraction (0-1) of the delay which is randomized.
+	Jitter float64
+	// RetryableStatusCodes the response status codes which should be retried.
+	RetryableStatusCodes []int
+	// RetryableMethods the http methods which can be retried.
+	RetryableMethods []string
+	// RetryTransportErrors whether retry the requests failed without a response.
+	RetryTransportErrors bool
+}
+
+// DefaultRetryPolicy Return a retry policy which retries GET and DELETE requests on 429 and 5xx errors.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableMethods:     []string{HttpGet, HttpDelete},
+		RetryTransportErrors: true,
+	}
+}
+
+// SetRetryPolicy Set the retry policy for the client, a nil policy disables retry.
+func (r *Client) SetRetryPolicy(policy *RetryPolicy) *Client {
+	r.RetryPolicy = policy
+	return r
+}
+
+func (p *RetryPolicy) isRetryableMethod(method string) bool {
+	for _, m := range p.RetryableMethods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) isRetryableStatus(code int) bool {
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff Return the delay before the given retry attempt, attempt starts from 1.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		delay -= delay * p.Jitter * rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+// shouldRetry Return whether the request should be retried after the attempt, and the delay before it.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, method string, attempt int, resp *resty.Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || ctx.Err() != nil || !p.isRetryableMethod(method) {
+		return 0, false
+	}
+	if err != nil {
+		return p.backoff(attempt), p.RetryTransportErrors
+	}
+	if resp == nil || !p.isRetryableStatus(resp.StatusCode()) {
+		return 0, false
+	}
+	if delay, ok := retryAfter(resp.Header(), time.Now()); ok {
+		if p.MaxDelay > 0 && delay > p.MaxDelay {
+			return 0, false
+		}
+		return delay, true
+	}
+	return p.backoff(attempt), true
+}
+
+// retryAfter Return the delay the server asks for by the Retry-After header, or by the
+// rate limit reset header when the rate limit is exhausted.
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			return nonNegative(time.Duration(seconds) * time.Second), true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(t.Sub(now)), true
+		}
+	}
+	if header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, ok := parseRateLimitReset(header.Get("X-RateLimit-Reset"), now); ok {
+			return nonNegative(reset.Sub(now)), true
+		}
+	}
+	return 0, false
+}
+
+// parseRateLimitReset Parse the rate limit reset header, which can be an unix timestamp
+// or the seconds until the limit is reset.
+func parseRateLimitReset(v string, now time.Time) (time.Time, bool) {
+	n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	if err != nil || n < 0 {
+		return time.Time{}, false
+	}
+	// Values as large as a timestamp are considered absolute times.
+	if n > 1000000000 {
+		return time.Unix(n, 0), true
+	}
+	return now.Add(time.Duration(n) * time.Second), true
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// sleepContext Wait for the delay, or until the context is done.
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,153 @@
+package pinterest
+
+import (
+	"context"
+	"errors"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.BaseDelay = time.Millisecond
+	p.MaxDelay = 5 * time.Millisecond
+	return p
+}
+
+func TestRetryOnServerError(t *testing.T) {
+	cli := NewBearerClient("").SetRetryPolicy(testRetryPolicy())
+	httpmock.ActivateNonDefault(cli.Cli.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	calls := 0
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/user_account",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls < 3 {
+				return httpmock.NewStringResponse(503, `{"code":503,"message":"Service unavailable"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"username":"merleliukun"}`), nil
+		},
+	)
+
+	u, err := cli.UserAccount.GetUserAccount("")
+	assert.Nil(t, err)
+	assert.Equal(t, *u.Username, "merleliukun")
+	assert.Equal(t, 3, calls)
+
+	// attempts exhausted
+	calls = -10
+	_, err = cli.UserAccount.GetUserAccount("")
+	assert.Equal(t, 503, err.(*APIError).StatusCode)
+	assert.Equal(t, -10+cli.RetryPolicy.MaxAttempts, calls)
+}
+
+func TestRetrySkipPost(t *testing.T) {
+	cli := NewBearerClient("").SetRetryPolicy(testRetryPolicy())
+	httpmock.ActivateNonDefault(cli.Cli.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	calls := 0
+	httpmock.RegisterResponder(
+		HttpPost, Baseurl+"/boards",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			return httpmock.NewStringResponse(429, `{"code":8,"message":"Too many requests"}`), nil
+		},
+	)
+
+	_, err := cli.Board.CreateBoard(CreateBoardOpts{Name: "board"})
+	assert.Equal(t, 8, err.(*APIError).Code)
+	assert.Equal(t, 1, calls)
+
+	// opt in for POST
+	cli.RetryPolicy.RetryableMethods = append(cli.RetryPolicy.RetryableMethods, HttpPost)
+	calls = 0
+	_, err = cli.Board.CreateBoard(CreateBoardOpts{Name: "board"})
+	assert.Equal(t, 8, err.(*APIError).Code)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryContextCancelled(t *testing.T) {
+	p := testRetryPolicy()
+	p.BaseDelay = time.Hour
+	p.MaxDelay = time.Hour
+	cli := NewBearerClient("").SetRetryPolicy(p)
+	httpmock.ActivateNonDefault(cli.Cli.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/user_account",
+		httpmock.NewStringResponder(500, `{"code":500,"message":"Internal error"}`),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := cli.UserAccount.GetUserAccountWithContext(ctx, "")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2022, 2, 14, 0, 0, 0, 0, time.UTC)
+
+	h := http.Header{}
+	_, ok := retryAfter(h, now)
+	assert.False(t, ok)
+
+	h.Set("Retry-After", "3")
+	d, ok := retryAfter(h, now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	h.Set("Retry-After", now.Add(time.Minute).Format(http.TimeFormat))
+	d, _ = retryAfter(h, now)
+	assert.Equal(t, time.Minute, d)
+
+	h = http.Header{}
+	h.Set("X-RateLimit-Remaining", "0")
+	h.Set("X-RateLimit-Reset", "10")
+	d, _ = retryAfter(h, now)
+	assert.Equal(t, 10*time.Second, d)
+
+	h.Set("X-RateLimit-Reset", "1644796830")
+	d, _ = retryAfter(h, now)
+	assert.Equal(t, 30*time.Second, d)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	assert.Equal(t, time.Second, p.backoff(1))
+	assert.Equal(t, 4*time.Second, p.backoff(3))
+	assert.Equal(t, 5*time.Second, p.backoff(10))
+
+	p.Jitter = 0.5
+	d := p.backoff(2)
+	assert.True(t, d > time.Second && d <= 2*time.Second)
+}
+
+func TestRetryAfterExceedsMaxDelay(t *testing.T) {
+	cli := NewBearerClient("").SetRetryPolicy(testRetryPolicy())
+	httpmock.ActivateNonDefault(cli.Cli.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	calls := 0
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/user_account",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			resp := httpmock.NewStringResponse(429, `{"code":8,"message":"Too many requests"}`)
+			resp.Header.Set("Retry-After", "3600")
+			return resp, nil
+		},
+	)
+
+	start := time.Now()
+	_, err := cli.UserAccount.GetUserAccount("")
+	assert.Equal(t, 429, err.(*APIError).StatusCode)
+	assert.Equal(t, 1, calls)
+	assert.True(t, time.Since(start) < time.Second)
+}
@@ -13,6 +13,11 @@ import (
 	"reflect"
 )
 
+var (
+	timestampType = reflect.TypeOf(Timestamp{})
+	unixTimeType  = reflect.TypeOf(UnixTime{})
+)
+
 // Stringify attempts to create a reasonable string representation of types in
 // the GitHub library. It does things like resolve pointers to their values
 // and omits struct fields with nil values.
@@ -53,6 +58,12 @@ func stringifyValue(w io.Writer, val reflect.Value) {
 			w.Write([]byte(v.Type().String()))
 		}
 
+		// special handling of the time values
+		if v.Type() == timestampType || v.Type() == unixTimeType {
+			fmt.Fprintf(w, "{%s}", v.Interface())
+			return
+		}
+
 		w.Write([]byte{'{'})
 
 		var sep bool
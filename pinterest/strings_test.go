@@ -8,6 +8,7 @@ package pinterest
 import (
 	"fmt"
 	"testing"
+	"time"
 )
 
 func TestStringify(t *testing.T) {
@@ -64,7 +65,7 @@ func TestString(t *testing.T) {
 		in  interface{}
 		out string
 	}{
-		{APIError{Code: 404, Message: "Pin not found."}, `pinterest.APIError{Code:404, Message:"Pin not found.", Status:"", Data:"", EndpointName:""}`},
+		{APIError{Code: 404, Message: "Pin not found."}, `pinterest.APIError{Code:404, Message:"Pin not found.", Status:"", Data:"", EndpointName:"", StatusCode:0, RequestID:""}`},
 		{UserAccount{Username: String("abc")}, `pinterest.UserAccount{Username:"abc"}`},
 		{AuthorizationAPP{ClientID: "client id", ClientSecret: "client secret"}, `pinterest.AuthorizationAPP{ClientID:"client id", ClientSecret:"client secret", RedirectURI:"", Scope:""}`},
 		{Metrics{Impression: Int64(3)}, `pinterest.Metrics{Impression:3}`},
@@ -72,15 +73,17 @@ func TestString(t *testing.T) {
 		{UserAccountAnalyticsMetrics{DailyMetrics: []*DailyMetrics{{DataStatus: String("READY"), Date: String("2022-02-10"), Metrics: &Metrics{Impression: Int64(3)}}}}, `pinterest.UserAccountAnalyticsMetrics{DailyMetrics:[pinterest.DailyMetrics{DataStatus:"READY", Date:"2022-02-10", Metrics:pinterest.Metrics{Impression:3}}]}`},
 		{UserAccountAnalytics{All: &UserAccountAnalyticsMetrics{DailyMetrics: []*DailyMetrics{{DataStatus: String("READY"), Date: String("2022-02-10"), Metrics: &Metrics{Impression: Int64(3)}}}}}, `pinterest.UserAccountAnalytics{All:pinterest.UserAccountAnalyticsMetrics{DailyMetrics:[pinterest.DailyMetrics{DataStatus:"READY", Date:"2022-02-10", Metrics:pinterest.Metrics{Impression:3}}]}}`},
 		{BoardOwner{Username: String("merleliukun")}, `pinterest.BoardOwner{Username:"merleliukun"}`},
-		{Board{ID: String("1022106146619699845"), Name: String("City"), Description: String(""), Owner: &BoardOwner{Username: String("merleliukun")}, Privacy: String("PUBLIC")}, `pinterest.Board{ID:"1022106146619699845", Name:"City", Description:"", Owner:pinterest.BoardOwner{Username:"merleliukun"}, Privacy:"PUBLIC"}`},
+		{Board{ID: String("1022106146619699845"), Name: String("City"), Description: String(""), Owner: &BoardOwner{Username: String("merleliukun")}, Privacy: Ptr(PrivacyPublic)}, `pinterest.Board{ID:"1022106146619699845", Name:"City", Description:"", Owner:pinterest.BoardOwner{Username:"merleliukun"}, Privacy:"PUBLIC"}`},
+		{BoardMedia{ImageCoverURL: String("https://i.pinimg.com/400x300/cover.jpg")}, `pinterest.BoardMedia{ImageCoverURL:"https://i.pinimg.com/400x300/cover.jpg"}`},
+		{Board{ID: String("1022106146619699845"), PinCount: Int(3), Media: &BoardMedia{PinThumbnailURLs: []string{"https://i.pinimg.com/150x150/1.jpg"}}}, `pinterest.Board{ID:"1022106146619699845", PinCount:3, Media:pinterest.BoardMedia{PinThumbnailURLs:["https://i.pinimg.com/150x150/1.jpg"]}}`},
 		{BoardsResponse{Items: []*Board{{ID: String("1022106146619699845"), Name: String("City")}}}, `pinterest.BoardsResponse{Items:[pinterest.Board{ID:"1022106146619699845", Name:"City"}]}`},
 		{BoardSection{ID: String("5215175925383086784"), Name: String("Day")}, `pinterest.BoardSection{ID:"5215175925383086784", Name:"Day"}`},
 		{BoardSectionsResponse{Items: []*BoardSection{{ID: String("5215175925383086784"), Name: String("Day")}}}, `pinterest.BoardSectionsResponse{Items:[pinterest.BoardSection{ID:"5215175925383086784", Name:"Day"}]}`},
-		{Pin{ID: String("1022106077902810180"), CreatedAt: String("2022-02-14T02:54:38")}, `pinterest.Pin{ID:"1022106077902810180", CreatedAt:"2022-02-14T02:54:38"}`},
-		{PinsResponse{Items: []*Pin{{ID: String("1022106077902810180"), CreatedAt: String("2022-02-14T02:54:38")}}}, `pinterest.PinsResponse{Items:[pinterest.Pin{ID:"1022106077902810180", CreatedAt:"2022-02-14T02:54:38"}]}`},
+		{Pin{ID: String("1022106077902810180"), CreatedAt: &Timestamp{time.Date(2022, 2, 14, 2, 54, 38, 0, time.UTC)}}, `pinterest.Pin{ID:"1022106077902810180", CreatedAt:pinterest.Timestamp{2022-02-14T02:54:38}}`},
+		{PinsResponse{Items: []*Pin{{ID: String("1022106077902810180"), CreatedAt: &Timestamp{time.Date(2022, 2, 14, 2, 54, 38, 0, time.UTC)}}}}, `pinterest.PinsResponse{Items:[pinterest.Pin{ID:"1022106077902810180", CreatedAt:pinterest.Timestamp{2022-02-14T02:54:38}}]}`},
 		{Media{MediaType: String("image")}, `pinterest.Media{MediaType:"image"}`},
 		{Image{Width: Int(10)}, `pinterest.Image{Width:10}`},
-		{MediaUpload{MediaID: String("5216393791692388749"), MediaType: String("video")}, `pinterest.MediaUpload{MediaID:"5216393791692388749", MediaType:"video"}`},
+		{MediaUpload{MediaID: String("5216393791692388749"), MediaType: Ptr(MediaTypeVideo)}, `pinterest.MediaUpload{MediaID:"5216393791692388749", MediaType:"video"}`},
 		{MediaUploadsResponse{Items: []*MediaUpload{{MediaID: String("5216393791692388749")}}}, `pinterest.MediaUploadsResponse{Items:[pinterest.MediaUpload{MediaID:"5216393791692388749"}]}`},
 		{RegisterMediaUploadResponse{MediaID: String("5216393791692388749"), UploadURL: String("https://p.com")}, `pinterest.RegisterMediaUploadResponse{MediaID:"5216393791692388749", UploadURL:"https://p.com"}`},
 		{Ad{ID: String("687195134316"), Type: String("pinpromotion")}, `pinterest.Ad{ID:"687195134316", Type:"pinpromotion"}`},
@@ -88,6 +91,7 @@ func TestString(t *testing.T) {
 		{AdAccount{ID: String("549763740754"), Name: String("SNS-SDKS")}, `pinterest.AdAccount{ID:"549763740754", Name:"SNS-SDKS"}`},
 		{AdAccountsResponse{Items: []*AdAccount{{ID: String("549763740754")}}}, `pinterest.AdAccountsResponse{Items:[pinterest.AdAccount{ID:"549763740754"}]}`},
 		{Campaign{ID: String("549755885175")}, `pinterest.Campaign{ID:"549755885175"}`},
+		{Campaign{ID: String("549755885175"), StartTime: &UnixTime{time.Unix(1644883200, 0)}}, `pinterest.Campaign{ID:"549755885175", StartTime:pinterest.UnixTime{2022-02-15T00:00:00Z}}`},
 		{CampaignsResponse{Items: []*Campaign{{ID: String("549755885175")}}}, `pinterest.CampaignsResponse{Items:[pinterest.Campaign{ID:"549755885175"}]}`},
 		{TrackingURLs{Impression: []*string{String("URL1")}}, `pinterest.TrackingURLs{Impression:["URL1"]}`},
 		{AdGroup{ID: String("2680060704746")}, `pinterest.AdGroup{ID:"2680060704746"}`},
@@ -0,0 +1,335 @@
+package pinterest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+/*
+	Targeting spec for the ad groups
+	Refer: https://developers.pinterest.com/docs/api/v5/#operation/ad_groups/create
+*/
+
+// AgeBucket represents the age range to target.
+type AgeBucket string
+
+// Targeting age bucket
+const (
+	AgeBucket18To24 AgeBucket = "18-24"
+	AgeBucket21Plus AgeBucket = "21+"
+	AgeBucket25To34 AgeBucket = "25-34"
+	AgeBucket35To44 AgeBucket = "35-44"
+	AgeBucket45To49 AgeBucket = "45-49"
+	AgeBucket50To54 AgeBucket = "50-54"
+	AgeBucket55To64 AgeBucket = "55-64"
+	AgeBucket65Plus AgeBucket = "65+"
+)
+
+func (a AgeBucket) IsValid() bool {
+	switch a {
+	case AgeBucket18To24, AgeBucket21Plus, AgeBucket25To34, AgeBucket35To44, AgeBucket45To49, AgeBucket50To54,
+		AgeBucket55To64, AgeBucket65Plus:
+		return true
+	}
+	return false
+}
+
+// Gender represents the gender to target.
+type Gender string
+
+// Targeting gender
+const (
+	GenderFemale  Gender = "female"
+	GenderMale    Gender = "male"
+	GenderUnknown Gender = "unknown"
+)
+
+func (g Gender) IsValid() bool {
+	return g == GenderFemale || g == GenderMale || g == GenderUnknown
+}
+
+// AppType represents the device type to target.
+type AppType string
+
+// Targeting app type
+const (
+	AppTypeIPhone  AppType = "iphone"
+	AppTypeIPad    AppType = "ipad"
+	AppTypeAndroid AppType = "android"
+	AppTypeWeb     AppType = "web"
+)
+
+func (a AppType) IsValid() bool {
+	switch a {
+	case AppTypeIPhone, AppTypeIPad, AppTypeAndroid, AppTypeWeb:
+		return true
+	}
+	return false
+}
+
+// Targeting spec keys
+const (
+	targetingAgeBucket       = "AGE_BUCKET"
+	targetingGender          = "GENDER"
+	targetingLocation        = "LOCATION"
+	targetingInterest        = "INTEREST"
+	targetingKeyword         = "KEYWORD"
+	targetingAudienceInclude = "AUDIENCE_INCLUDE"
+	targetingAudienceExclude = "AUDIENCE_EXCLUDE"
+	targetingAppType         = "APPTYPE"
+	targetingLocale          = "LOCALE"
+	targetingGeo             = "GEO"
+)
+
+// TargetingSpec represents the targeting of an ad group. The keys not modeled are kept in Other,
+// so a spec read from the api is sent back unchanged.
+type TargetingSpec struct {
+	AgeBucket       []AgeBucket
+	Gender          []Gender
+	Location        []string
+	Interest        []string
+	Keyword         []string
+	AudienceInclude []string
+	AudienceExclude []string
+	AppType         []AppType
+	Locale          []string
+	Geo             []string
+	Other           map[string][]string
+}
+
+func (t TargetingSpec) String() string {
+	return Stringify(t)
+}
+
+func (t TargetingSpec) MarshalJSON() ([]byte, error) {
+	m := make(map[string][]string, len(t.Other)+10)
+	for k, v := range t.Other {
+		m[k] = v
+	}
+	set := func(key string, values []string) {
+		if len(values) > 0 {
+			m[key] = values
+		}
+	}
+	set(targetingAgeBucket, enumStrings(t.AgeBucket))
+	set(targetingGender, enumStrings(t.Gender))
+	set(targetingLocation, t.Location)
+	set(targetingInterest, t.Interest)
+	set(targetingKeyword, t.Keyword)
+	set(targetingAudienceInclude, t.AudienceInclude)
+	set(targetingAudienceExclude, t.AudienceExclude)
+	set(targetingAppType, enumStrings(t.AppType))
+	set(targetingLocale, t.Locale)
+	set(targetingGeo, t.Geo)
+	return json.Marshal(m)
+}
+
+func (t *TargetingSpec) UnmarshalJSON(data []byte) error {
+	var m map[string][]string
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	spec := TargetingSpec{}
+	for key, values := range m {
+		switch key {
+		case targetingAgeBucket:
+			spec.AgeBucket = stringsToEnums[AgeBucket](values)
+		case targetingGender:
+			spec.Gender = stringsToEnums[Gender](values)
+		case targetingLocation:
+			spec.Location = values
+		case targetingInterest:
+			spec.Interest = values
+		case targetingKeyword:
+			spec.Keyword = values
+		case targetingAudienceInclude:
+			spec.AudienceInclude = values
+		case targetingAudienceExclude:
+			spec.AudienceExclude = values
+		case targetingAppType:
+			spec.AppType = stringsToEnums[AppType](values)
+		case targetingLocale:
+			spec.Locale = values
+		case targetingGeo:
+			spec.Geo = values
+		default:
+			if spec.Other == nil {
+				spec.Other = make(map[string][]string)
+			}
+			spec.Other[key] = values
+		}
+	}
+	*t = spec
+	return nil
+}
+
+func enumStrings[T ~string](values []T) []string {
+	if values == nil {
+		return nil
+	}
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = string(v)
+	}
+	return s
+}
+
+func stringsToEnums[T ~string](values []string) []T {
+	if values == nil {
+		return nil
+	}
+	s := make([]T, len(values))
+	for i, v := range values {
+		s[i] = T(v)
+	}
+	return s
+}
+
+// Validate Check the enum values, and an audience is not both included and excluded.
+func (t *TargetingSpec) Validate() error {
+	if err := validateEnums(targetingAgeBucket, t.AgeBucket); err != nil {
+		return err
+	}
+	if err := validateEnums(targetingGender, t.Gender); err != nil {
+		return err
+	}
+	if err := validateEnums(targetingAppType, t.AppType); err != nil {
+		return err
+	}
+	included := make(map[string]bool, len(t.AudienceInclude))
+	for _, id := range t.AudienceInclude {
+		included[id] = true
+	}
+	for i, id := range t.AudienceExclude {
+		if included[id] {
+			return &ValidationError{Field: fmt.Sprintf("%s[%d]", targetingAudienceExclude, i), Message: "audience " + id + " is also included"}
+		}
+	}
+	return nil
+}
+
+// TargetingSpecBuilder builds a TargetingSpec step by step.
+//
+//	spec, err := pinterest.NewTargetingSpecBuilder().
+//		AgeBuckets(pinterest.AgeBucket25To34, pinterest.AgeBucket35To44).
+//		Genders(pinterest.GenderFemale).
+//		Locations("US").
+//		Build()
+type TargetingSpecBuilder struct {
+	spec TargetingSpec
+}
+
+// NewTargetingSpecBuilder Return a builder for an empty targeting spec.
+func NewTargetingSpecBuilder() *TargetingSpecBuilder {
+	return &TargetingSpecBuilder{}
+}
+
+// AgeBuckets Add the age buckets to target.
+func (b *TargetingSpecBuilder) AgeBuckets(buckets ...AgeBucket) *TargetingSpecBuilder {
+	b.spec.AgeBucket = append(b.spec.AgeBucket, buckets...)
+	return b
+}
+
+// Genders Add the genders to target.
+func (b *TargetingSpecBuilder) Genders(genders ...Gender) *TargetingSpecBuilder {
+	b.spec.Gender = append(b.spec.Gender, genders...)
+	return b
+}
+
+// Locations Add the locations to target, like the country codes or the metro codes.
+func (b *TargetingSpecBuilder) Locations(locations ...string) *TargetingSpecBuilder {
+	b.spec.Location = append(b.spec.Location, locations...)
+	return b
+}
+
+// Interests Add the interest ids to target.
+func (b *TargetingSpecBuilder) Interests(interests ...string) *TargetingSpecBuilder {
+	b.spec.Interest = append(b.spec.Interest, interests...)
+	return b
+}
+
+// Keywords Add the keywords to target.
+func (b *TargetingSpecBuilder) Keywords(keywords ...string) *TargetingSpecBuilder {
+	b.spec.Keyword = append(b.spec.Keyword, keywords...)
+	return b
+}
+
+// IncludeAudiences Add the audience ids to target.
+func (b *TargetingSpecBuilder) IncludeAudiences(audiences ...string) *TargetingSpecBuilder {
+	b.spec.AudienceInclude = append(b.spec.AudienceInclude, audiences...)
+	return b
+}
+
+// ExcludeAudiences Add the audience ids not to target.
+func (b *TargetingSpecBuilder) ExcludeAudiences(audiences ...string) *TargetingSpecBuilder {
+	b.spec.AudienceExclude = append(b.spec.AudienceExclude, audiences...)
+	return b
+}
+
+// AppTypes Add the device types to target.
+func (b *TargetingSpecBuilder) AppTypes(appTypes ...AppType) *TargetingSpecBuilder {
+	b.spec.AppType = append(b.spec.AppType, appTypes...)
+	return b
+}
+
+// Locales Add the locales to target, like "en-US".
+func (b *TargetingSpecBuilder) Locales(locales ...string) *TargetingSpecBuilder {
+	b.spec.Locale = append(b.spec.Locale, locales...)
+	return b
+}
+
+// Geos Add the geo regions to target, like "US-CA".
+func (b *TargetingSpecBuilder) Geos(geos ...string) *TargetingSpecBuilder {
+	b.spec.Geo = append(b.spec.Geo, geos...)
+	return b
+}
+
+// Set Set the values for a key not modeled by the spec.
+func (b *TargetingSpecBuilder) Set(key string, values ...string) *TargetingSpecBuilder {
+	if b.spec.Other == nil {
+		b.spec.Other = make(map[string][]string)
+	}
+	b.spec.Other[key] = values
+	return b
+}
+
+// Build Validate and return the targeting spec.
+// The returned spec is a copy, it is not changed by the later calls of the builder.
+func (b *TargetingSpecBuilder) Build() (*TargetingSpec, error) {
+	spec := b.spec.clone()
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// clone Return a deep copy of the spec.
+func (t TargetingSpec) clone() TargetingSpec {
+	t.AgeBucket = cloneSlice(t.AgeBucket)
+	t.Gender = cloneSlice(t.Gender)
+	t.Location = cloneSlice(t.Location)
+	t.Interest = cloneSlice(t.Interest)
+	t.Keyword = cloneSlice(t.Keyword)
+	t.AudienceInclude = cloneSlice(t.AudienceInclude)
+	t.AudienceExclude = cloneSlice(t.AudienceExclude)
+	t.AppType = cloneSlice(t.AppType)
+	t.Locale = cloneSlice(t.Locale)
+	t.Geo = cloneSlice(t.Geo)
+	if t.Other != nil {
+		other := make(map[string][]string, len(t.Other))
+		for k, v := range t.Other {
+			other[k] = cloneSlice(v)
+		}
+		t.Other = other
+	}
+	return t
+}
+
+// cloneSlice Return a copy of the slice, nil for nil.
+func cloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
@@ -0,0 +1,58 @@
+package pinterest
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTargetingSpecJSON(t *testing.T) {
+	data := `{"AGE_BUCKET":["25-34","35-44"],"GENDER":["female"],"LOCATION":["US"],"APPTYPE":["iphone","web"],"SHOPPING_RETARGETING":["1"]}`
+	spec := new(TargetingSpec)
+	assert.Nil(t, json.Unmarshal([]byte(data), spec))
+	assert.Equal(t, []AgeBucket{AgeBucket25To34, AgeBucket35To44}, spec.AgeBucket)
+	assert.Equal(t, []Gender{GenderFemale}, spec.Gender)
+	assert.Equal(t, []AppType{AppTypeIPhone, AppTypeWeb}, spec.AppType)
+	assert.Equal(t, map[string][]string{"SHOPPING_RETARGETING": {"1"}}, spec.Other)
+
+	out, err := json.Marshal(spec)
+	assert.Nil(t, err)
+	assert.JSONEq(t, data, string(out))
+}
+
+func TestTargetingSpecBuilder(t *testing.T) {
+	spec, err := NewTargetingSpecBuilder().
+		AgeBuckets(AgeBucket18To24).
+		Genders(GenderMale, GenderUnknown).
+		Locations("US").
+		Interests("935249274030").
+		Keywords("garden tools").
+		IncludeAudiences("2542620905473").
+		ExcludeAudiences("2542620905474").
+		AppTypes(AppTypeAndroid).
+		Locales("en-US").
+		Geos("US-CA").
+		Set("TARGETING_STRATEGY", "CHOOSE_YOUR_OWN").
+		Build()
+	assert.Nil(t, err)
+
+	out, _ := json.Marshal(spec)
+	assert.JSONEq(t, `{"AGE_BUCKET":["18-24"],"GENDER":["male","unknown"],"LOCATION":["US"],"INTEREST":["935249274030"],"KEYWORD":["garden tools"],"AUDIENCE_INCLUDE":["2542620905473"],"AUDIENCE_EXCLUDE":["2542620905474"],"APPTYPE":["android"],"LOCALE":["en-US"],"GEO":["US-CA"],"TARGETING_STRATEGY":["CHOOSE_YOUR_OWN"]}`, string(out))
+
+	// the built spec is not changed by the builder
+	values := []string{"1", "2"}
+	builder := NewTargetingSpecBuilder().AgeBuckets(AgeBucket18To24, AgeBucket25To34).Set("KEY", values...)
+	built, err := builder.Build()
+	assert.Nil(t, err)
+	builder.spec.AgeBucket = builder.spec.AgeBucket[:1]
+	builder.AgeBuckets(AgeBucket35To44).Set("KEY", "3").Set("OTHER", "4")
+	values[0] = "5"
+	assert.Equal(t, []AgeBucket{AgeBucket18To24, AgeBucket25To34}, built.AgeBucket)
+	assert.Equal(t, map[string][]string{"KEY": {"1", "2"}}, built.Other)
+
+	_, err = NewTargetingSpecBuilder().AgeBuckets("18-21").Build()
+	assert.Equal(t, "AGE_BUCKET[0]", err.(*ValidationError).Field)
+
+	_, err = NewTargetingSpecBuilder().IncludeAudiences("1", "2").ExcludeAudiences("2").Build()
+	assert.Equal(t, "AUDIENCE_EXCLUDE[0]", err.(*ValidationError).Field)
+}
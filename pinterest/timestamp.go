@@ -0,0 +1,93 @@
+package pinterest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+/*
+	Time types for the api timestamps
+*/
+
+// TimestampLayout is the layout of the timestamps like the pin created_at, which are in UTC without the zone.
+const TimestampLayout = "2006-01-02T15:04:05"
+
+// DateLayout is the layout of the dates like the DATE column of the ads analytics.
+const DateLayout = "2006-01-02"
+
+// Timestamp represents a time encoded as a string like "2022-02-14T02:54:38".
+// RFC 3339 strings, dates and unix seconds are accepted too when decoding.
+type Timestamp struct {
+	time.Time
+}
+
+// String Return the time in the api format.
+func (t Timestamp) String() string {
+	return t.UTC().Format(TimestampLayout)
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) == 0 || data[0] != '"' {
+		sec, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return fmt.Errorf("pinterest: invalid timestamp %s", data)
+		}
+		t.Time = time.Unix(sec, 0).UTC()
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	for _, layout := range []string{TimestampLayout, time.RFC3339Nano, "2006-01-02T15:04:05.999999999", DateLayout} {
+		if v, err := time.Parse(layout, s); err == nil {
+			t.Time = v.UTC()
+			return nil
+		}
+	}
+	return fmt.Errorf("pinterest: invalid timestamp %q", s)
+}
+
+// UnixTime represents a time encoded as the unix seconds, like the start_time of campaigns.
+type UnixTime struct {
+	time.Time
+}
+
+// NewUnixTime Return the unix time for t, truncated to seconds.
+func NewUnixTime(t time.Time) *UnixTime {
+	return &UnixTime{Time: time.Unix(t.Unix(), 0).UTC()}
+}
+
+// String Return the time in RFC 3339 format.
+func (t UnixTime) String() string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+func (t *UnixTime) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	// Some endpoints return the numbers as strings.
+	s := string(bytes.Trim(data, `"`))
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("pinterest: invalid unix time %s", data)
+	}
+	t.Time = time.Unix(sec, 0).UTC()
+	return nil
+}
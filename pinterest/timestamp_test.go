@@ -0,0 +1,45 @@
+package pinterest
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTimestamp(t *testing.T) {
+	want := time.Date(2022, 2, 14, 2, 54, 38, 0, time.UTC)
+	for _, data := range []string{`"2022-02-14T02:54:38"`, `"2022-02-14T02:54:38Z"`, `"2022-02-14T10:54:38+08:00"`, `1644807278`} {
+		var ts Timestamp
+		assert.Nil(t, json.Unmarshal([]byte(data), &ts), data)
+		assert.True(t, ts.Equal(want), data)
+	}
+
+	var ts Timestamp
+	assert.NotNil(t, json.Unmarshal([]byte(`"yesterday"`), &ts))
+
+	pin := new(Pin)
+	assert.Nil(t, json.Unmarshal([]byte(`{"id":"1022106077902810180","created_at":"2022-02-14T02:54:38"}`), pin))
+	assert.Equal(t, want, pin.CreatedAt.Time)
+	data, err := json.Marshal(pin.CreatedAt)
+	assert.Nil(t, err)
+	assert.Equal(t, `"2022-02-14T02:54:38"`, string(data))
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"created_at":null}`), pin))
+}
+
+func TestUnixTime(t *testing.T) {
+	want := time.Unix(1644883200, 0)
+	for _, data := range []string{`1644883200`, `"1644883200"`} {
+		var ut UnixTime
+		assert.Nil(t, json.Unmarshal([]byte(data), &ut), data)
+		assert.True(t, ut.Equal(want), data)
+	}
+
+	var ut UnixTime
+	assert.NotNil(t, json.Unmarshal([]byte(`"2022-02-15"`), &ut))
+
+	data, err := json.Marshal(NewUnixTime(want.Add(500 * time.Millisecond)))
+	assert.Nil(t, err)
+	assert.Equal(t, `1644883200`, string(data))
+}
@@ -0,0 +1,185 @@
+package pinterest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"golang.org/x/oauth2"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+/*
+	Token store for the user tokens
+*/
+
+// ErrTokenNotFound is returned by the TokenStore when no token is saved for the key.
+var ErrTokenNotFound = errors.New("pinterest: token not found")
+
+// TokenStore saves and loads the user tokens by a key, e.g. the user id in your system.
+type TokenStore interface {
+	Load(ctx context.Context, key string) (*oauth2.Token, error)
+	Save(ctx context.Context, key string, token *oauth2.Token) error
+}
+
+// MemoryTokenStore is a TokenStore keeping the tokens in memory.
+type MemoryTokenStore struct {
+	mu     sync.RWMutex
+	tokens map[string]*oauth2.Token
+}
+
+// NewMemoryTokenStore Return an empty memory token store.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: make(map[string]*oauth2.Token)}
+}
+
+func (s *MemoryTokenStore) Load(ctx context.Context, key string) (*oauth2.Token, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	token, ok := s.tokens[key]
+	if !ok {
+		return nil, ErrTokenNotFound
+	}
+	t := *token
+	return &t, nil
+}
+
+func (s *MemoryTokenStore) Save(ctx context.Context, key string, token *oauth2.Token) error {
+	if token == nil {
+		return errors.New("pinterest: can not save a nil token")
+	}
+	t := *token
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[key] = &t
+	return nil
+}
+
+// FileTokenStore is a TokenStore saving each token as a json file in the directory.
+// The files are replaced atomically, so a crash while saving never leaves a broken token.
+type FileTokenStore struct {
+	Dir string
+}
+
+// NewFileTokenStore Return a file token store in the dir, the dir is created when saving if not exists.
+func NewFileTokenStore(dir string) *FileTokenStore {
+	return &FileTokenStore{Dir: dir}
+}
+
+func (s *FileTokenStore) path(key string) string {
+	return filepath.Join(s.Dir, url.PathEscape(key)+".json")
+}
+
+func (s *FileTokenStore) Load(ctx context.Context, key string) (*oauth2.Token, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	token := new(oauth2.Token)
+	if err = json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (s *FileTokenStore) Save(ctx context.Context, key string, token *oauth2.Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(s.Dir, 0700); err != nil {
+		return err
+	}
+
+	// Write to a temp file in the same dir, then rename it over the old one.
+	f, err := os.CreateTemp(s.Dir, ".token-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path(key))
+}
+
+// TokenStoreOpts represents the options for the token source saving the refreshed tokens to the store.
+type TokenStoreOpts struct {
+	// OnSaveError is called when a refreshed token fails to be saved. The token is still used, and the save is
+	// retried on the next request.
+	OnSaveError func(key string, token *oauth2.Token, err error)
+}
+
+// storeTokenSource is a token source saving the token to the store once it is refreshed.
+type storeTokenSource struct {
+	ctx   context.Context
+	store TokenStore
+	key   string
+	base  oauth2.TokenSource
+	opts  TokenStoreOpts
+
+	mu      sync.Mutex
+	last    *oauth2.Token
+	unsaved bool
+}
+
+func (s *storeTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.base.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.unsaved && s.last != nil && s.last.AccessToken == token.AccessToken && s.last.RefreshToken == token.RefreshToken {
+		return token, nil
+	}
+	// Keep the refreshed token even if the save fails, the refresh token may be rotated and the old one revoked.
+	s.last = token
+	if err = s.store.Save(s.ctx, s.key, token); err != nil {
+		s.unsaved = true
+		if s.opts.OnSaveError != nil {
+			s.opts.OnSaveError(s.key, token, err)
+		}
+		return token, nil
+	}
+	s.unsaved = false
+	return token, nil
+}
+
+// StoreTokenSource Return a token source starting with the token, which refreshes the token when expired
+// and saves every refreshed token to the store by the key.
+func (app *AuthorizationAPP) StoreTokenSource(ctx context.Context, store TokenStore, key string, token *oauth2.Token, opts TokenStoreOpts) oauth2.TokenSource {
+	return &storeTokenSource{
+		ctx:   ctx,
+		store: store,
+		key:   key,
+		base:  app.Config.TokenSource(ctx, token),
+		opts:  opts,
+		last:  token,
+	}
+}
+
+// GetUserClientFromStore Return the library client with the user token loaded from the store,
+// the refreshed tokens are saved back to the store.
+func (app *AuthorizationAPP) GetUserClientFromStore(ctx context.Context, store TokenStore, key string, opts TokenStoreOpts) (*Client, error) {
+	token, err := store.Load(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	hc := oauth2.NewClient(ctx, app.StoreTokenSource(ctx, store, key, token, opts))
+	return NewUserClint(hc), nil
+}
@@ -0,0 +1,152 @@
+package pinterest
+
+import (
+	"context"
+	"errors"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testTokenStore(t *testing.T, store TokenStore) {
+	ctx := context.Background()
+	_, err := store.Load(ctx, "user/1")
+	assert.Equal(t, ErrTokenNotFound, err)
+
+	token := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "bearer", Expiry: time.Unix(1644796830, 0)}
+	assert.Nil(t, store.Save(ctx, "user/1", token))
+	loaded, err := store.Load(ctx, "user/1")
+	assert.Nil(t, err)
+	assert.Equal(t, "access", loaded.AccessToken)
+	assert.Equal(t, "refresh", loaded.RefreshToken)
+	assert.True(t, token.Expiry.Equal(loaded.Expiry))
+
+	token.AccessToken = "new access"
+	assert.Nil(t, store.Save(ctx, "user/1", token))
+	loaded, _ = store.Load(ctx, "user/1")
+	assert.Equal(t, "new access", loaded.AccessToken)
+}
+
+func TestMemoryTokenStore(t *testing.T) {
+	testTokenStore(t, NewMemoryTokenStore())
+	assert.NotNil(t, NewMemoryTokenStore().Save(context.Background(), "user", nil))
+}
+
+func TestFileTokenStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tokens")
+	testTokenStore(t, NewFileTokenStore(dir))
+
+	files, _ := os.ReadDir(dir)
+	assert.Len(t, files, 1)
+	assert.Equal(t, "user%2F1.json", files[0].Name())
+
+	os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0600)
+	_, err := NewFileTokenStore(dir).Load(context.Background(), "broken")
+	assert.NotNil(t, err)
+}
+
+func TestGetUserClientFromStore(t *testing.T) {
+	httpmock.ActivateNonDefault(http.DefaultClient)
+	defer httpmock.DeactivateAndReset()
+
+	refreshed := 0
+	httpmock.RegisterResponder(
+		HttpPost, Endpoint.TokenURL,
+		func(req *http.Request) (*http.Response, error) {
+			refreshed++
+			return httpmock.NewStringResponse(200, `{"access_token":"new access","token_type":"bearer","expires_in":3600,"refresh_token":"new refresh"}`), nil
+		},
+	)
+	var authorization string
+	httpmock.RegisterResponder(
+		HttpGet, Baseurl+"/user_account",
+		func(req *http.Request) (*http.Response, error) {
+			authorization = req.Header.Get("Authorization")
+			return httpmock.NewStringResponse(200, `{"username":"merleliukun"}`), nil
+		},
+	)
+
+	app := NewAuthorizationAPP(AuthorizationAPP{ClientID: "client id", ClientSecret: "client secret"})
+	store := NewMemoryTokenStore()
+	ctx := context.Background()
+
+	_, err := app.GetUserClientFromStore(ctx, store, "user", TokenStoreOpts{})
+	assert.Equal(t, ErrTokenNotFound, err)
+
+	store.Save(ctx, "user", &oauth2.Token{AccessToken: "old access", RefreshToken: "old refresh", Expiry: time.Now().Add(-time.Hour)})
+	cli, err := app.GetUserClientFromStore(ctx, store, "user", TokenStoreOpts{})
+	assert.Nil(t, err)
+	u, err := cli.UserAccount.GetUserAccount("")
+	assert.Nil(t, err)
+	assert.Equal(t, "merleliukun", *u.Username)
+	assert.Equal(t, "Bearer new access", authorization)
+
+	saved, _ := store.Load(ctx, "user")
+	assert.Equal(t, "new access", saved.AccessToken)
+	assert.Equal(t, "new refresh", saved.RefreshToken)
+
+	// valid token is reused without refresh or saving
+	cli.UserAccount.GetUserAccount("")
+	assert.Equal(t, 1, refreshed)
+}
+
+type failingTokenStore struct {
+	*MemoryTokenStore
+	fail bool
+}
+
+func (s *failingTokenStore) Save(ctx context.Context, key string, token *oauth2.Token) error {
+	if s.fail {
+		return errors.New("store is down")
+	}
+	return s.MemoryTokenStore.Save(ctx, key, token)
+}
+
+func TestStoreTokenSourceSaveError(t *testing.T) {
+	httpmock.ActivateNonDefault(http.DefaultClient)
+	defer httpmock.DeactivateAndReset()
+
+	refreshed := 0
+	httpmock.RegisterResponder(
+		HttpPost, Endpoint.TokenURL,
+		func(req *http.Request) (*http.Response, error) {
+			refreshed++
+			return httpmock.NewStringResponse(200, `{"access_token":"new access","token_type":"bearer","expires_in":3600,"refresh_token":"new refresh"}`), nil
+		},
+	)
+
+	app := NewAuthorizationAPP(AuthorizationAPP{ClientID: "client id", ClientSecret: "client secret"})
+	store := &failingTokenStore{MemoryTokenStore: NewMemoryTokenStore(), fail: true}
+	ctx := context.Background()
+	var saveErrs []error
+	ts := app.StoreTokenSource(ctx, store, "user", &oauth2.Token{AccessToken: "old access", RefreshToken: "old refresh", Expiry: time.Now().Add(-time.Hour)}, TokenStoreOpts{
+		OnSaveError: func(key string, token *oauth2.Token, err error) {
+			assert.Equal(t, "user", key)
+			assert.Equal(t, "new access", token.AccessToken)
+			saveErrs = append(saveErrs, err)
+		},
+	})
+
+	// the refreshed token is used even if it is not saved
+	token, err := ts.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "new access", token.AccessToken)
+	assert.Len(t, saveErrs, 1)
+	_, err = store.Load(ctx, "user")
+	assert.Equal(t, ErrTokenNotFound, err)
+
+	// the next call only retries the save
+	store.fail = false
+	token, err = ts.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "new access", token.AccessToken)
+	assert.Len(t, saveErrs, 1)
+	assert.Equal(t, 1, refreshed)
+	saved, _ := store.Load(ctx, "user")
+	assert.Equal(t, "new refresh", saved.RefreshToken)
+}
@@ -1,5 +1,7 @@
 package pinterest
 
+import "context"
+
 /*
 	User Account API
 */
@@ -32,16 +34,21 @@ type userAccountOpts struct {
 
 // GetUserAccount Get account information for the user account
 // Refer: https://developers.pinterest.com/docs/api/v5/#operation/user_account/get
-func (r *UserAccountResource) GetUserAccount(adAccountID string) (*UserAccount, *APIError) {
+func (r *UserAccountResource) GetUserAccount(adAccountID string) (*UserAccount, error) {
+	return r.GetUserAccountWithContext(context.Background(), adAccountID)
+}
+
+// GetUserAccountWithContext is the same as GetUserAccount, but with a context for the request.
+func (r *UserAccountResource) GetUserAccountWithContext(ctx context.Context, adAccountID string) (*UserAccount, error) {
 	path := "/user_account"
 
 	resp := new(UserAccount)
-	var err *APIError
+	var err error
 	if adAccountID != "" {
 		params := userAccountOpts{AdAccountID: adAccountID}
-		err = r.Cli.DoGet(path, params, resp)
+		err = r.Cli.DoGetWithContext(ctx, path, params, resp)
 	} else {
-		err = r.Cli.DoGet(path, nil, resp)
+		err = r.Cli.DoGetWithContext(ctx, path, nil, resp)
 	}
 	if err != nil {
 		return nil, err
@@ -1,5 +1,7 @@
 package pinterest
 
+import "context"
+
 /*
 	User Account Analytics API
 	Refer: https://developers.pinterest.com/docs/api/v5/#operation/user_account/analytics
@@ -20,6 +22,20 @@ type Metrics struct {
 	OutboundClickRate *float64 `json:"OUTBOUND_CLICK_RATE"`
 	PinClick          *int64   `json:"PIN_CLICK"`
 	PinClickRate      *float64 `json:"PIN_CLICK_RATE"`
+	// Metrics for the pins
+	ProfileVisit                *int64   `json:"PROFILE_VISIT"`
+	UserFollow                  *int64   `json:"USER_FOLLOW"`
+	TotalComments               *int64   `json:"TOTAL_COMMENTS"`
+	TotalReactions              *int64   `json:"TOTAL_REACTIONS"`
+	VideoMRCView                *int64   `json:"VIDEO_MRC_VIEW"`
+	VideoStart                  *int64   `json:"VIDEO_START"`
+	VideoAvgWatchTime           *float64 `json:"VIDEO_AVG_WATCH_TIME"`
+	VideoV50WatchTime           *int64   `json:"VIDEO_V50_WATCH_TIME"`
+	Quartile95PercentView       *int64   `json:"QUARTILE_95_PERCENT_VIEW"`
+	Video10SView                *int64   `json:"VIDEO_10S_VIEW"`
+	FullScreenPlay              *int64   `json:"FULL_SCREEN_PLAY"`
+	FullScreenPlaytime          *int64   `json:"FULL_SCREEN_PLAYTIME"`
+	TotalIdeaPinProductTagVisit *int64   `json:"TOTAL_IDEA_PIN_PRODUCT_TAG_VISIT"`
 }
 
 func (m Metrics) String() string {
@@ -69,11 +85,16 @@ type UserAccountAnalyticsOpts struct {
 }
 
 // GetUserAccountAnalytics Get analytics for the user account.
-func (r *UserAccountResource) GetUserAccountAnalytics(args UserAccountAnalyticsOpts) (*UserAccountAnalytics, *APIError) {
+func (r *UserAccountResource) GetUserAccountAnalytics(args UserAccountAnalyticsOpts) (*UserAccountAnalytics, error) {
+	return r.GetUserAccountAnalyticsWithContext(context.Background(), args)
+}
+
+// GetUserAccountAnalyticsWithContext is the same as GetUserAccountAnalytics, but with a context for the request.
+func (r *UserAccountResource) GetUserAccountAnalyticsWithContext(ctx context.Context, args UserAccountAnalyticsOpts) (*UserAccountAnalytics, error) {
 	path := "/user_account/analytics"
 
 	resp := new(UserAccountAnalytics)
-	err := r.Cli.DoGet(path, args, resp)
+	err := r.Cli.DoGetWithContext(ctx, path, args, resp)
 	if err != nil {
 		return nil, err
 	}